@@ -11,7 +11,7 @@ func main() {
 	n8nHost := "https://your-n8n-instance.com/api/v1"
 	n8nApiKey := "your-api-key"
 
-	n8nClient, err := client.NewClient(&n8nHost, &n8nApiKey)
+	n8nClient, err := client.New(n8nHost, n8nApiKey)
 	if err != nil {
 		log.Fatal("Error creating client: ", err)
 	}
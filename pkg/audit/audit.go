@@ -0,0 +1,188 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+)
+
+type Audit struct {
+	Client *client.Client
+}
+
+// RiskCategory is a category of the security audit
+type RiskCategory string
+
+const (
+	RiskCredentials RiskCategory = "credentials"
+	RiskDatabase    RiskCategory = "database"
+	RiskNodes       RiskCategory = "nodes"
+	RiskFilesystem  RiskCategory = "filesystem"
+	RiskInstance    RiskCategory = "instance"
+)
+
+// GenerateAuditOptions restricts the audit to some categories and sets the
+// number of days after which an inactive workflow is considered abandoned
+type GenerateAuditOptions struct {
+	Categories            []RiskCategory
+	DaysAbandonedWorkflow int
+}
+
+// N8nAuditReport holds the risk report of every audited category, a nil report
+// means the category was not audited or had no findings
+type N8nAuditReport struct {
+	Credentials *N8nRiskReport `json:"Credentials Risk Report,omitempty"`
+	Database    *N8nRiskReport `json:"Database Risk Report,omitempty"`
+	Nodes       *N8nRiskReport `json:"Nodes Risk Report,omitempty"`
+	Filesystem  *N8nRiskReport `json:"Filesystem Risk Report,omitempty"`
+	Instance    *N8nRiskReport `json:"Instance Risk Report,omitempty"`
+}
+
+type N8nRiskReport struct {
+	Risk     RiskCategory     `json:"risk"`
+	Sections []N8nRiskSection `json:"sections"`
+}
+
+type N8nRiskSection struct {
+	Title          string            `json:"title"`
+	Description    string            `json:"description"`
+	Recommendation string            `json:"recommendation"`
+	Location       []N8nRiskLocation `json:"location,omitempty"`
+	// NextVersions lists newer n8n versions in the outdated instance section
+	NextVersions []N8nInstanceVersion `json:"nextVersions,omitempty"`
+	// Settings holds the instance settings in the security settings section
+	Settings map[string]interface{} `json:"settings,omitempty"`
+}
+
+// N8nRiskLocation points at what a finding is about. Kind is credential, node,
+// community or custom and decides which fields are set
+type N8nRiskLocation struct {
+	Kind         string `json:"kind"`
+	Id           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	WorkflowId   string `json:"workflowId,omitempty"`
+	WorkflowName string `json:"workflowName,omitempty"`
+	NodeId       string `json:"nodeId,omitempty"`
+	NodeName     string `json:"nodeName,omitempty"`
+	NodeType     string `json:"nodeType,omitempty"`
+	PackageUrl   string `json:"packageUrl,omitempty"`
+	FilePath     string `json:"filePath,omitempty"`
+}
+
+type N8nInstanceVersion struct {
+	Name                    string `json:"name"`
+	ReleasedAt              string `json:"releasedAt"`
+	Description             string `json:"description,omitempty"`
+	DocumentationUrl        string `json:"documentationUrl,omitempty"`
+	HasBreakingChange       bool   `json:"hasBreakingChange,omitempty"`
+	HasSecurityFix          bool   `json:"hasSecurityFix,omitempty"`
+	HasSecurityIssue        bool   `json:"hasSecurityIssue,omitempty"`
+	SecurityIssueFixVersion string `json:"securityIssueFixVersion,omitempty"`
+}
+
+// N8nFinding is a single location flagged by a section of the audit
+type N8nFinding struct {
+	Risk     RiskCategory
+	Section  string
+	Location N8nRiskLocation
+}
+
+func NewAudit(client *client.Client) *Audit {
+	return &Audit{Client: client}
+}
+
+// GenerateAudit runs the security audit of the instance
+func (a *Audit) GenerateAudit(opts GenerateAuditOptions) (N8nAuditReport, error) {
+	return a.GenerateAuditContext(context.Background(), opts)
+}
+
+// GenerateAuditContext is like GenerateAudit but binds the request to ctx
+func (a *Audit) GenerateAuditContext(ctx context.Context, opts GenerateAuditOptions) (N8nAuditReport, error) {
+	if opts.DaysAbandonedWorkflow < 0 {
+		return N8nAuditReport{}, fmt.Errorf("daysAbandonedWorkflow should not be negative")
+	}
+
+	additionalOptions := map[string]interface{}{}
+	if len(opts.Categories) > 0 {
+		additionalOptions["categories"] = opts.Categories
+	}
+	if opts.DaysAbandonedWorkflow > 0 {
+		additionalOptions["daysAbandonedWorkflow"] = opts.DaysAbandonedWorkflow
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{"additionalOptions": additionalOptions})
+	if err != nil {
+		return N8nAuditReport{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/audit", a.Client.HostURL), bytes.NewReader(payload))
+	if err != nil {
+		return N8nAuditReport{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.Client.DoRequest(req)
+
+	if err != nil {
+		return N8nAuditReport{}, err
+	}
+
+	// an audit without any finding is answered with an empty array
+	if trimmed := bytes.TrimSpace(resp); bytes.Equal(trimmed, []byte("[]")) {
+		return N8nAuditReport{}, nil
+	}
+
+	var report N8nAuditReport
+	err = json.Unmarshal(resp, &report)
+
+	if err != nil {
+		return N8nAuditReport{}, err
+	}
+
+	return report, nil
+}
+
+// Reports returns the non empty risk reports in a stable order
+func (r N8nAuditReport) Reports() []N8nRiskReport {
+	var reports []N8nRiskReport
+	for _, report := range []*N8nRiskReport{r.Credentials, r.Database, r.Nodes, r.Filesystem, r.Instance} {
+		if report != nil {
+			reports = append(reports, *report)
+		}
+	}
+	return reports
+}
+
+// Findings flattens the report into one finding per flagged location
+func (r N8nAuditReport) Findings() []N8nFinding {
+	var findings []N8nFinding
+	for _, report := range r.Reports() {
+		for _, section := range report.Sections {
+			for _, location := range section.Location {
+				findings = append(findings, N8nFinding{Risk: report.Risk, Section: section.Title, Location: location})
+			}
+		}
+	}
+	return findings
+}
+
+// NewFindings returns the findings of the report that are not part of baseline,
+// e.g. risky nodes or unused credentials that appeared since the last audit
+func (r N8nAuditReport) NewFindings(baseline N8nAuditReport) []N8nFinding {
+	known := make(map[N8nFinding]bool)
+	for _, finding := range baseline.Findings() {
+		known[finding] = true
+	}
+
+	var findings []N8nFinding
+	for _, finding := range r.Findings() {
+		if !known[finding] {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
@@ -0,0 +1,166 @@
+package audit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+const auditResponse = `{
+	"Credentials Risk Report": {
+		"risk": "credentials",
+		"sections": [{
+			"title": "Credentials not used in any workflow",
+			"description": "These credentials are not used in any workflow.",
+			"recommendation": "Consider deleting these credentials.",
+			"location": [{"kind": "credential", "id": "1", "name": "Old API"}]
+		}]
+	},
+	"Nodes Risk Report": {
+		"risk": "nodes",
+		"sections": [{
+			"title": "Official risky nodes",
+			"description": "These nodes are part of n8n.",
+			"recommendation": "Restrict these nodes.",
+			"location": [{
+				"kind": "node",
+				"workflowId": "w1",
+				"workflowName": "Backup",
+				"nodeId": "n1",
+				"nodeName": "Execute Command",
+				"nodeType": "n8n-nodes-base.executeCommand"
+			}]
+		}]
+	},
+	"Instance Risk Report": {
+		"risk": "instance",
+		"sections": [{
+			"title": "Outdated instance",
+			"description": "This n8n instance is outdated.",
+			"recommendation": "Update to the latest version.",
+			"nextVersions": [{"name": "1.2.0", "releasedAt": "2024-01-01", "hasSecurityFix": true}]
+		}]
+	}
+}`
+
+func TestNewAudit(t *testing.T) {
+	c := &client.Client{}
+	a := NewAudit(c)
+	assert.NotNil(t, a)
+	assert.Equal(t, c, a.Client)
+}
+
+func TestGenerateAudit(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         GenerateAuditOptions
+		response     string
+		status       int
+		expectedBody string
+		expectError  bool
+		expectedRisk []RiskCategory
+	}{
+		{
+			name:         "full report",
+			response:     auditResponse,
+			status:       http.StatusOK,
+			expectedBody: `{"additionalOptions":{}}`,
+			expectedRisk: []RiskCategory{RiskCredentials, RiskNodes, RiskInstance},
+		},
+		{
+			name:         "categories and abandoned days",
+			opts:         GenerateAuditOptions{Categories: []RiskCategory{RiskCredentials, RiskNodes}, DaysAbandonedWorkflow: 30},
+			response:     `[]`,
+			status:       http.StatusOK,
+			expectedBody: `{"additionalOptions":{"categories":["credentials","nodes"],"daysAbandonedWorkflow":30}}`,
+		},
+		{
+			name:         "api error",
+			response:     `{"message":"forbidden"}`,
+			status:       http.StatusForbidden,
+			expectedBody: `{"additionalOptions":{}}`,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/audit", r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, tt.expectedBody, string(body))
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			a := NewAudit(c)
+
+			report, err := a.GenerateAudit(tt.opts)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var risks []RiskCategory
+			for _, r := range report.Reports() {
+				risks = append(risks, r.Risk)
+			}
+			assert.Equal(t, tt.expectedRisk, risks)
+		})
+	}
+}
+
+func TestGenerateAuditParsesSections(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(auditResponse))
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	report, err := NewAudit(c).GenerateAudit(GenerateAuditOptions{})
+
+	assert.NoError(t, err)
+	assert.Nil(t, report.Database)
+	assert.Equal(t, "n8n-nodes-base.executeCommand", report.Nodes.Sections[0].Location[0].NodeType)
+	assert.Equal(t, "Backup", report.Nodes.Sections[0].Location[0].WorkflowName)
+	assert.True(t, report.Instance.Sections[0].NextVersions[0].HasSecurityFix)
+	assert.Len(t, report.Findings(), 2)
+}
+
+func TestGenerateAuditNegativeDays(t *testing.T) {
+	c, _ := client.New("http://localhost", "test")
+
+	_, err := NewAudit(c).GenerateAudit(GenerateAuditOptions{DaysAbandonedWorkflow: -1})
+
+	assert.Error(t, err)
+}
+
+func TestNewFindings(t *testing.T) {
+	unused := N8nRiskLocation{Kind: "credential", Id: "1", Name: "Old API"}
+	risky := N8nRiskLocation{Kind: "node", WorkflowId: "w1", NodeId: "n1", NodeType: "n8n-nodes-base.executeCommand"}
+
+	baseline := N8nAuditReport{
+		Credentials: &N8nRiskReport{Risk: RiskCredentials, Sections: []N8nRiskSection{
+			{Title: "Credentials not used in any workflow", Location: []N8nRiskLocation{unused}},
+		}},
+	}
+	current := N8nAuditReport{
+		Credentials: baseline.Credentials,
+		Nodes: &N8nRiskReport{Risk: RiskNodes, Sections: []N8nRiskSection{
+			{Title: "Official risky nodes", Location: []N8nRiskLocation{risky}},
+		}},
+	}
+
+	assert.Equal(t, []N8nFinding{{Risk: RiskNodes, Section: "Official risky nodes", Location: risky}}, current.NewFindings(baseline))
+	assert.Empty(t, baseline.NewFindings(current))
+}
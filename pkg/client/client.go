@@ -1,34 +1,34 @@
 package client
 
 import (
-	"crypto/tls"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"time"
 )
 
-// HostURL - Default Hashicups URL
-const HostURL string = "http://localhost:19090"
+// HostURL - Default n8n API URL used by NewClient when no host is given
+const HostURL string = "http://localhost:5678/api/v1"
 
 // Client -
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+	// UserAgent is sent as the User-Agent header when not empty
+	UserAgent string
+	// RetryPolicy enables retries of transient failures, nil disables them
+	RetryPolicy *RetryPolicy
 }
 
 // NewClient -
+//
+// Deprecated: use New, which accepts options for TLS, proxies and timeouts
 func NewClient(host, token *string) (*Client, error) {
-
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-	}
-
-	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second, Transport: tr},
-		HostURL:    HostURL,
+	c, err := New(HostURL, "")
+	if err != nil {
+		return nil, err
 	}
 
 	if host != nil {
@@ -39,51 +39,114 @@ func NewClient(host, token *string) (*Client, error) {
 		c.Token = *token
 	}
 
-	return &c, nil
+	return c, nil
 }
 
 // N8nPaginatedResponse represents a paginated API response with a cursor and data
 type N8nPaginatedResponse struct {
-	Data   json.RawMessage `json:"data"`
-	Cursor string          `json:"cursor,omitempty"`
+	Data       json.RawMessage `json:"data"`
+	Cursor     string          `json:"cursor,omitempty"`
+	NextCursor string          `json:"nextCursor,omitempty"`
 }
 
-type N8nErrorResponse struct {
-	Message string `json:"message"`
+// next returns the cursor of the following page, n8n list endpoints send it as nextCursor
+func (p N8nPaginatedResponse) next() string {
+	if p.NextCursor != "" {
+		return p.NextCursor
+	}
+	return p.Cursor
 }
 
 // DoRequest performs an HTTP request and returns the response body
 func (c *Client) DoRequest(req *http.Request) ([]byte, error) {
+	return c.DoRequestContext(req.Context(), req)
+}
+
+// DoRequestContext performs an HTTP request bound to ctx and returns the response body
+func (c *Client) DoRequestContext(ctx context.Context, req *http.Request) ([]byte, error) {
+	req = req.WithContext(ctx)
+
 	if c.Token == "" {
 		return nil, fmt.Errorf("no token provided")
 	}
 
 	req.Header.Set("X-N8n-API-KEY", c.Token)
 	req.Header.Set("Accept", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+	policy := c.RetryPolicy
+	if policy != nil && !policy.allowsMethod(req.Method) {
+		policy = nil
+	}
+
+	var res *http.Response
+	var body []byte
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		res, body, err = c.send(req)
+
+		if policy == nil || attempt >= policy.MaxAttempts || !policy.shouldRetry(res, err) {
+			break
+		}
+
+		// a request body can only be sent again if it can be rewound
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				break
+			}
+			rewound, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				break
+			}
+			req.Body = rewound
+		}
+
+		if sleepErr := sleepContext(ctx, policy.backoff(attempt, res)); sleepErr != nil {
+			return nil, sleepErr
+		}
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		var errorResp N8nErrorResponse
-		json.Unmarshal(body, &errorResp)
-		return nil, fmt.Errorf("status: %d, message: %s", res.StatusCode, errorResp.Message)
+	// creations answer with 201 and updates without body with 204
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, newAPIError(req, res, body)
 	}
 
 	return body, err
 }
 
+// send performs a single attempt of req and reads the whole response body
+func (c *Client) send(req *http.Request) (*http.Response, []byte, error) {
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, body, nil
+}
+
 // GetPaginated performs a GET request with cursor-based pagination support
-// It automatically handles fetching all pages
+// It automatically handles fetching all pages and buffers them in memory,
+// use Paginate or a Pager to stream large collections instead
 func (c *Client) GetPaginated(req *http.Request) ([]byte, error) {
+	return c.GetPaginatedContext(req.Context(), req)
+}
+
+// GetPaginatedContext is like GetPaginated but every page request is bound to ctx,
+// so cancelling ctx stops the pagination between pages as well as mid-request
+func (c *Client) GetPaginatedContext(ctx context.Context, req *http.Request) ([]byte, error) {
 	var allData []json.RawMessage
 	cursor := ""
 
@@ -95,7 +158,7 @@ func (c *Client) GetPaginated(req *http.Request) ([]byte, error) {
 			req.URL.RawQuery = q.Encode()
 		}
 
-		body, err := c.DoRequest(req)
+		body, err := c.DoRequestContext(ctx, req)
 		if err != nil {
 			return nil, err
 		}
@@ -124,10 +187,10 @@ func (c *Client) GetPaginated(req *http.Request) ([]byte, error) {
 		}
 
 		// If no more pages, break
-		if page.Cursor == "" {
+		if page.next() == "" {
 			break
 		}
-		cursor = page.Cursor
+		cursor = page.next()
 	}
 
 	// Combine all data into a single JSON array
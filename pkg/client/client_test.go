@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -73,6 +74,24 @@ func TestDoRequest(t *testing.T) {
 			expectError:   false,
 			expectMessage: `{"status":"ok"}`,
 		},
+		{
+			name: "created request",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"id":"1"}`))
+			})),
+			expectError:   false,
+			expectMessage: `{"id":"1"}`,
+		},
+		{
+			name: "failed request",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"message":"request/body must have required property 'name'"}`))
+			})),
+			expectError:   true,
+			expectMessage: "request/body must have required property 'name'",
+		},
 	}
 
 	for _, tt := range tests {
@@ -97,6 +116,57 @@ func TestDoRequest(t *testing.T) {
 	}
 }
 
+func TestDoRequestContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		cancel      bool
+		expectError bool
+	}{
+		{
+			name:        "active context",
+			cancel:      false,
+			expectError: false,
+		},
+		{
+			name:        "cancelled context",
+			cancel:      true,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"status":"ok"}`))
+			}))
+			defer server.Close()
+
+			host := server.URL
+			token := "test"
+
+			client, _ := NewClient(&host, &token)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancel {
+				cancel()
+			} else {
+				defer cancel()
+			}
+
+			req, _ := http.NewRequest("GET", server.URL, nil)
+			body, err := client.DoRequestContext(ctx, req)
+
+			if tt.expectError {
+				assert.ErrorIs(t, err, context.Canceled)
+			} else {
+				assert.NoError(t, err)
+				assert.JSONEq(t, `{"status":"ok"}`, string(body))
+			}
+		})
+	}
+}
+
 func TestGetPaginated(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -152,6 +222,32 @@ func TestGetPaginated(t *testing.T) {
 	}
 }
 
+func TestGetPaginatedContextCancelledBetweenPages(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// cancel while serving the first page so the next page is never requested
+		cancel()
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":[1,2],"cursor":"next"}`))
+	}))
+	defer server.Close()
+
+	host := server.URL
+	token := "test"
+
+	client, _ := NewClient(&host, &token)
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	_, err := client.GetPaginatedContext(ctx, req)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, requests)
+}
+
 // Helper function to get a string pointer
 func stringPtr(s string) *string {
 	return &s
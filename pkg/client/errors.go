@@ -0,0 +1,71 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors matched by APIError through errors.Is
+var (
+	ErrBadRequest      = errors.New("bad request")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrForbidden       = errors.New("forbidden")
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrTooManyRequests = errors.New("too many requests")
+	ErrServer          = errors.New("server error")
+)
+
+// APIError is returned by DoRequest when n8n answers with a non 2xx status code
+type APIError struct {
+	StatusCode int
+	Message    string
+	Body       []byte
+	Method     string
+	URL        string
+}
+
+// N8nErrorResponse is the error payload returned by the n8n API
+type N8nErrorResponse struct {
+	Message string `json:"message"`
+}
+
+// newAPIError builds an APIError from a failed response
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	var errorResp N8nErrorResponse
+	json.Unmarshal(body, &errorResp)
+
+	return &APIError{
+		StatusCode: res.StatusCode,
+		Message:    errorResp.Message,
+		Body:       body,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+	}
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("status: %d, message: %s", e.StatusCode, e.Message)
+}
+
+// Is makes errors.Is match the sentinel error corresponding to the status code
+func (e *APIError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return target == ErrBadRequest
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict:
+		return target == ErrConflict
+	case http.StatusTooManyRequests:
+		return target == ErrTooManyRequests
+	}
+
+	return e.StatusCode >= 500 && target == ErrServer
+}
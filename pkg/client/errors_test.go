@@ -0,0 +1,89 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		body            string
+		expectSentinel  error
+		expectedMessage string
+	}{
+		{
+			name:            "not found",
+			status:          http.StatusNotFound,
+			body:            `{"message":"Not Found"}`,
+			expectSentinel:  ErrNotFound,
+			expectedMessage: "Not Found",
+		},
+		{
+			name:            "unauthorized",
+			status:          http.StatusUnauthorized,
+			body:            `{"message":"unauthorized"}`,
+			expectSentinel:  ErrUnauthorized,
+			expectedMessage: "unauthorized",
+		},
+		{
+			name:            "conflict",
+			status:          http.StatusConflict,
+			body:            `{"message":"Tag already exists"}`,
+			expectSentinel:  ErrConflict,
+			expectedMessage: "Tag already exists",
+		},
+		{
+			name:            "server error",
+			status:          http.StatusInternalServerError,
+			body:            `not json`,
+			expectSentinel:  ErrServer,
+			expectedMessage: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			host := server.URL
+			token := "test"
+
+			client, _ := NewClient(&host, &token)
+
+			req, _ := http.NewRequest("GET", server.URL+"/workflows/1", nil)
+			_, err := client.DoRequest(req)
+
+			assert.ErrorIs(t, err, tt.expectSentinel)
+			assert.ErrorIs(t, fmt.Errorf("wrapped: %w", err), tt.expectSentinel)
+
+			var apiErr *APIError
+			if assert.True(t, errors.As(err, &apiErr)) {
+				assert.Equal(t, tt.status, apiErr.StatusCode)
+				assert.Equal(t, tt.expectedMessage, apiErr.Message)
+				assert.Equal(t, tt.body, string(apiErr.Body))
+				assert.Equal(t, "GET", apiErr.Method)
+				assert.Equal(t, server.URL+"/workflows/1", apiErr.URL)
+			}
+		})
+	}
+}
+
+func TestAPIErrorDoesNotMatchOtherSentinels(t *testing.T) {
+	err := &APIError{StatusCode: http.StatusNotFound}
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrConflict)
+	assert.NotErrorIs(t, err, ErrUnauthorized)
+	assert.NotErrorIs(t, err, ErrServer)
+}
@@ -0,0 +1,189 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout is the HTTP timeout used when WithTimeout is not given
+const DefaultTimeout = 10 * time.Second
+
+// DefaultUserAgent is the User-Agent header sent when WithUserAgent is not given
+const DefaultUserAgent = "n8n-client-go"
+
+// Option configures a Client built with New
+type Option func(*options) error
+
+// options collects the settings applied by Option before the client is built
+type options struct {
+	httpClient         *http.Client
+	transport          http.RoundTripper
+	rootCAs            *x509.CertPool
+	certificates       []tls.Certificate
+	proxy              func(*http.Request) (*url.URL, error)
+	timeout            time.Duration
+	userAgent          string
+	insecureSkipVerify bool
+	retryPolicy        *RetryPolicy
+}
+
+// usesTLSOrProxy reports whether an option that configures the built-in transport was given
+func (o *options) usesTLSOrProxy() bool {
+	return o.rootCAs != nil || len(o.certificates) > 0 || o.proxy != nil || o.insecureSkipVerify
+}
+
+// WithHTTPClient uses the given HTTP client as is, the timeout and transport
+// options are ignored
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *options) error {
+		if httpClient == nil {
+			return fmt.Errorf("http client can not be nil")
+		}
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport uses the given RoundTripper instead of the built-in transport
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *options) error {
+		if transport == nil {
+			return fmt.Errorf("transport can not be nil")
+		}
+		o.transport = transport
+		return nil
+	}
+}
+
+// WithRootCAs verifies the server certificate against the given CA pool
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(o *options) error {
+		if pool == nil {
+			return fmt.Errorf("ca pool can not be nil")
+		}
+		o.rootCAs = pool
+		return nil
+	}
+}
+
+// WithClientCertificate presents the given certificate for mutual TLS
+func WithClientCertificate(certificate tls.Certificate) Option {
+	return func(o *options) error {
+		o.certificates = append(o.certificates, certificate)
+		return nil
+	}
+}
+
+// WithProxy routes requests through the given proxy URL
+func WithProxy(proxyURL *url.URL) Option {
+	return func(o *options) error {
+		if proxyURL == nil {
+			return fmt.Errorf("proxy url can not be nil")
+		}
+		o.proxy = http.ProxyURL(proxyURL)
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of every HTTP request
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout can not be negative")
+		}
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(o *options) error {
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the server certificate.
+// Only use it against development instances with self-signed certificates
+func WithInsecureSkipVerify() Option {
+	return func(o *options) error {
+		o.insecureSkipVerify = true
+		return nil
+	}
+}
+
+// WithRetryPolicy enables retries of transient failures
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(o *options) error {
+		o.retryPolicy = policy
+		return nil
+	}
+}
+
+// New creates a client for the n8n API at baseURL (e.g. https://n8n.example.com/api/v1)
+// authenticated with apiKey. Server certificates are verified unless
+// WithInsecureSkipVerify is given
+func New(baseURL, apiKey string, opts ...Option) (*Client, error) {
+	parsedURL, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base url: %w", err)
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid base url %q: scheme must be http or https", baseURL)
+	}
+
+	o := options{
+		timeout:   DefaultTimeout,
+		userAgent: DefaultUserAgent,
+	}
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+
+	if (o.httpClient != nil || o.transport != nil) && o.usesTLSOrProxy() {
+		return nil, fmt.Errorf("tls and proxy options can not be combined with a custom http client or transport")
+	}
+
+	httpClient := o.httpClient
+	if httpClient == nil {
+		transport := o.transport
+		if transport == nil {
+			transport = o.buildTransport()
+		}
+		httpClient = &http.Client{Timeout: o.timeout, Transport: transport}
+	}
+
+	return &Client{
+		HostURL:     strings.TrimSuffix(baseURL, "/"),
+		HTTPClient:  httpClient,
+		Token:       apiKey,
+		UserAgent:   o.userAgent,
+		RetryPolicy: o.retryPolicy,
+	}, nil
+}
+
+// buildTransport creates the built-in transport from the TLS and proxy options
+func (o *options) buildTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		RootCAs:            o.rootCAs,
+		Certificates:       o.certificates,
+		InsecureSkipVerify: o.insecureSkipVerify,
+	}
+
+	if o.proxy != nil {
+		transport.Proxy = o.proxy
+	}
+
+	return transport
+}
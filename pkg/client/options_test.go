@@ -0,0 +1,186 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+	customClient := &http.Client{}
+
+	tests := []struct {
+		name        string
+		baseURL     string
+		options     []Option
+		expectError bool
+		check       func(t *testing.T, c *Client)
+	}{
+		{
+			name:    "secure defaults",
+			baseURL: "https://n8n.example.com/api/v1/",
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "https://n8n.example.com/api/v1", c.HostURL)
+				assert.Equal(t, "key", c.Token)
+				assert.Equal(t, DefaultUserAgent, c.UserAgent)
+				assert.Equal(t, DefaultTimeout, c.HTTPClient.Timeout)
+				transport := c.HTTPClient.Transport.(*http.Transport)
+				assert.False(t, transport.TLSClientConfig.InsecureSkipVerify)
+				assert.Equal(t, uint16(tls.VersionTLS12), transport.TLSClientConfig.MinVersion)
+			},
+		},
+		{
+			name:    "tls and proxy options",
+			baseURL: "https://n8n.example.com/api/v1",
+			options: []Option{
+				WithRootCAs(x509.NewCertPool()),
+				WithClientCertificate(tls.Certificate{}),
+				WithProxy(proxyURL),
+				WithTimeout(time.Minute),
+				WithUserAgent("my-agent"),
+				WithInsecureSkipVerify(),
+			},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "my-agent", c.UserAgent)
+				assert.Equal(t, time.Minute, c.HTTPClient.Timeout)
+				transport := c.HTTPClient.Transport.(*http.Transport)
+				assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+				assert.NotNil(t, transport.TLSClientConfig.RootCAs)
+				assert.Len(t, transport.TLSClientConfig.Certificates, 1)
+				req, _ := http.NewRequest("GET", "https://n8n.example.com", nil)
+				proxy, err := transport.Proxy(req)
+				assert.NoError(t, err)
+				assert.Equal(t, proxyURL, proxy)
+			},
+		},
+		{
+			name:    "custom http client",
+			baseURL: "https://n8n.example.com/api/v1",
+			options: []Option{WithHTTPClient(customClient)},
+			check: func(t *testing.T, c *Client) {
+				assert.Same(t, customClient, c.HTTPClient)
+			},
+		},
+		{
+			name:    "custom transport",
+			baseURL: "https://n8n.example.com/api/v1",
+			options: []Option{WithTransport(http.DefaultTransport)},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, http.DefaultTransport, c.HTTPClient.Transport)
+			},
+		},
+		{
+			name:    "retry policy",
+			baseURL: "https://n8n.example.com/api/v1",
+			options: []Option{WithRetryPolicy(DefaultRetryPolicy())},
+			check: func(t *testing.T, c *Client) {
+				assert.NotNil(t, c.RetryPolicy)
+			},
+		},
+		{
+			name:        "tls option with custom transport",
+			baseURL:     "https://n8n.example.com/api/v1",
+			options:     []Option{WithTransport(http.DefaultTransport), WithInsecureSkipVerify()},
+			expectError: true,
+		},
+		{
+			name:        "invalid scheme",
+			baseURL:     "n8n.example.com",
+			expectError: true,
+		},
+		{
+			name:        "nil http client",
+			baseURL:     "https://n8n.example.com/api/v1",
+			options:     []Option{WithHTTPClient(nil)},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.baseURL, "key", tt.options...)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, c)
+			} else {
+				assert.NoError(t, err)
+				tt.check(t, c)
+			}
+		})
+	}
+}
+
+func TestNewUserAgent(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c, err := New(server.URL, "key", WithUserAgent("deploy-pipeline/1.0"))
+	assert.NoError(t, err)
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	_, err = c.DoRequest(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "deploy-pipeline/1.0", userAgent)
+}
+
+func TestNewVerifiesServerCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	tests := []struct {
+		name        string
+		options     []Option
+		expectError bool
+	}{
+		{
+			name:        "untrusted certificate",
+			options:     nil,
+			expectError: true,
+		},
+		{
+			name:        "trusted ca pool",
+			options:     []Option{WithRootCAs(pool)},
+			expectError: false,
+		},
+		{
+			name:        "insecure opt in",
+			options:     []Option{WithInsecureSkipVerify()},
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(server.URL, "key", tt.options...)
+			assert.NoError(t, err)
+
+			req, _ := http.NewRequest("GET", server.URL, nil)
+			_, err = c.DoRequest(req)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
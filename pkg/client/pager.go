@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"strconv"
+)
+
+// Pager fetches the pages of a cursor-paginated endpoint one request at a time.
+// Cursor and Offset can be saved and set on a new Pager to resume where the
+// previous one stopped, including in the middle of a page consumed by Items
+type Pager struct {
+	client *Client
+	req    *http.Request
+	done   bool
+
+	// Cursor is sent with the next page request, empty for the first page
+	Cursor string
+	// Offset is the number of items of the page at Cursor that were already
+	// consumed, they are skipped when the page is fetched
+	Offset int
+	// Limit sets the page size, the server default is used when zero
+	Limit int
+}
+
+// NewPager creates a Pager for the GET request req
+func (c *Client) NewPager(req *http.Request) *Pager {
+	return &Pager{client: c, req: req}
+}
+
+// HasNext reports whether there are pages left to fetch
+func (p *Pager) HasNext() bool {
+	return !p.done
+}
+
+// Next fetches the next page and returns its raw items
+func (p *Pager) Next(ctx context.Context) ([]json.RawMessage, error) {
+	if p.done {
+		return nil, fmt.Errorf("no more pages")
+	}
+
+	req := p.req.Clone(ctx)
+	q := req.URL.Query()
+	if p.Cursor != "" {
+		q.Set("cursor", p.Cursor)
+	}
+	if p.Limit > 0 {
+		q.Set("limit", strconv.Itoa(p.Limit))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body, err := p.client.DoRequestContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var page N8nPaginatedResponse
+	if err := json.Unmarshal(body, &page); err != nil {
+		// endpoints without pagination answer with a plain array
+		var items []json.RawMessage
+		if err := json.Unmarshal(body, &items); err != nil {
+			return nil, fmt.Errorf("error decoding page: %v", err)
+		}
+		p.done = true
+		return p.skip(items), nil
+	}
+
+	var items []json.RawMessage
+	if len(page.Data) > 0 {
+		if err := json.Unmarshal(page.Data, &items); err != nil {
+			return nil, fmt.Errorf("error decoding page data: %v", err)
+		}
+	}
+
+	items = p.skip(items)
+	p.Cursor = page.next()
+	if p.Cursor == "" {
+		p.done = true
+	}
+
+	return items, nil
+}
+
+// skip drops the items of the current page consumed before, as recorded in Offset
+func (p *Pager) skip(items []json.RawMessage) []json.RawMessage {
+	offset := min(p.Offset, len(items))
+	p.Offset = 0
+	return items[offset:]
+}
+
+// stopAt records the position of the item at index of the page fetched with
+// cursor and offset, so that Cursor and Offset resume from that item
+func (p *Pager) stopAt(cursor string, offset, index int) {
+	p.Cursor = cursor
+	p.Offset = offset + index
+	p.done = false
+}
+
+// Items returns an iterator over the items of every remaining page of p, decoded as T.
+// Pages are only requested as the iteration advances, and iteration stops after
+// the first error. When the loop stops early, Cursor and Offset point right
+// after the last item yielded
+func Items[T any](ctx context.Context, p *Pager) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for p.HasNext() {
+			cursor, offset := p.Cursor, p.Offset
+			page, err := p.Next(ctx)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for i, raw := range page {
+				var item T
+				if err := json.Unmarshal(raw, &item); err != nil {
+					p.stopAt(cursor, offset, i)
+					yield(item, fmt.Errorf("error decoding item: %v", err))
+					return
+				}
+				if !yield(item, nil) {
+					if i+1 < len(page) {
+						p.stopAt(cursor, offset, i+1)
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+// Paginate returns an iterator over the items of every page of the GET request req
+func Paginate[T any](ctx context.Context, c *Client, req *http.Request) iter.Seq2[T, error] {
+	return Items[T](ctx, c.NewPager(req))
+}
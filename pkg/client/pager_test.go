@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newPagedServer serves the items 1 to 5 in pages of two, using the page number as cursor
+func newPagedServer(requests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"data":[1,2],"cursor":"page2"}`))
+		case "page2":
+			w.Write([]byte(`{"data":[3,4],"nextCursor":"page3"}`))
+		default:
+			w.Write([]byte(`{"data":[5],"nextCursor":null}`))
+		}
+	}))
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name             string
+		stopAfter        int
+		expected         []int
+		expectedRequests int32
+	}{
+		{
+			name:             "all pages",
+			stopAfter:        0,
+			expected:         []int{1, 2, 3, 4, 5},
+			expectedRequests: 3,
+		},
+		{
+			name:             "stop early",
+			stopAfter:        3,
+			expected:         []int{1, 2, 3},
+			expectedRequests: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			server := newPagedServer(&requests)
+			defer server.Close()
+
+			c, _ := New(server.URL, "test")
+			req, _ := http.NewRequest("GET", server.URL, nil)
+
+			var result []int
+			for item, err := range Paginate[int](context.Background(), c, req) {
+				assert.NoError(t, err)
+				result = append(result, item)
+				if tt.stopAfter > 0 && len(result) == tt.stopAfter {
+					break
+				}
+			}
+
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.expectedRequests, atomic.LoadInt32(&requests))
+		})
+	}
+}
+
+func TestPagerResume(t *testing.T) {
+	var requests int32
+	server := newPagedServer(&requests)
+	defer server.Close()
+
+	c, _ := New(server.URL, "test")
+	req, _ := http.NewRequest("GET", server.URL, nil)
+
+	pager := c.NewPager(req)
+	pager.Limit = 2
+	page, err := pager.Next(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, page, 2)
+	assert.Equal(t, "page2", pager.Cursor)
+
+	resumed := c.NewPager(req)
+	resumed.Cursor = pager.Cursor
+
+	var result []int
+	for item, err := range Items[int](context.Background(), resumed) {
+		assert.NoError(t, err)
+		result = append(result, item)
+	}
+
+	assert.Equal(t, []int{3, 4, 5}, result)
+	assert.False(t, resumed.HasNext())
+}
+
+func TestPagerResumeMidPage(t *testing.T) {
+	tests := []struct {
+		name           string
+		stopAfter      int
+		expectedCursor string
+		expectedOffset int
+		expectedRest   []int
+	}{
+		{
+			name:           "inside a page",
+			stopAfter:      3,
+			expectedCursor: "page2",
+			expectedOffset: 1,
+			expectedRest:   []int{4, 5},
+		},
+		{
+			name:           "at a page boundary",
+			stopAfter:      4,
+			expectedCursor: "page3",
+			expectedOffset: 0,
+			expectedRest:   []int{5},
+		},
+		{
+			name:           "inside the first page",
+			stopAfter:      1,
+			expectedCursor: "",
+			expectedOffset: 1,
+			expectedRest:   []int{2, 3, 4, 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			server := newPagedServer(&requests)
+			defer server.Close()
+
+			c, _ := New(server.URL, "test")
+			req, _ := http.NewRequest("GET", server.URL, nil)
+
+			pager := c.NewPager(req)
+			var seen int
+			for _, err := range Items[int](context.Background(), pager) {
+				assert.NoError(t, err)
+				seen++
+				if seen == tt.stopAfter {
+					break
+				}
+			}
+
+			assert.Equal(t, tt.expectedCursor, pager.Cursor)
+			assert.Equal(t, tt.expectedOffset, pager.Offset)
+			assert.True(t, pager.HasNext())
+
+			resumed := c.NewPager(req)
+			resumed.Cursor = pager.Cursor
+			resumed.Offset = pager.Offset
+
+			var result []int
+			for item, err := range Items[int](context.Background(), resumed) {
+				assert.NoError(t, err)
+				result = append(result, item)
+			}
+
+			assert.Equal(t, tt.expectedRest, result)
+			assert.False(t, resumed.HasNext())
+		})
+	}
+}
+
+func TestPagerLimit(t *testing.T) {
+	var limit string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit = r.URL.Query().Get("limit")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":[],"cursor":""}`))
+	}))
+	defer server.Close()
+
+	c, _ := New(server.URL, "test")
+	req, _ := http.NewRequest("GET", server.URL, nil)
+
+	pager := c.NewPager(req)
+	pager.Limit = 250
+	_, err := pager.Next(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "250", limit)
+	assert.False(t, pager.HasNext())
+}
+
+func TestPaginateError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message":"unauthorized"}`))
+	}))
+	defer server.Close()
+
+	c, _ := New(server.URL, "test")
+	req, _ := http.NewRequest("GET", server.URL, nil)
+
+	var errs []error
+	for _, err := range Paginate[int](context.Background(), c, req) {
+		errs = append(errs, err)
+	}
+
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrUnauthorized)
+}
@@ -0,0 +1,198 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how DoRequest retries transient failures
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts, including the one asked with Retry-After
+	MaxBackoff time.Duration
+	// Multiplier is applied to the delay after every attempt
+	Multiplier float64
+	// Jitter is the fraction (0 to 1) of the delay that is randomized
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP status codes that are retried
+	RetryableStatusCodes []int
+	// Methods lists the HTTP methods that are retried, add POST or PUT to opt in
+	Methods []string
+	// RetryableError reports whether a transport error is retried, defaults to IsRetryableError
+	RetryableError func(error) bool
+	// RespectRetryAfter makes the delay follow the Retry-After header when the server sends one
+	RespectRetryAfter bool
+}
+
+// DefaultRetryPolicy returns a policy that retries idempotent requests on
+// rate limiting, gateway errors and network errors
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		Methods: []string{
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+			http.MethodDelete,
+		},
+		RetryableError:    IsRetryableError,
+		RespectRetryAfter: true,
+	}
+}
+
+// IsRetryableError reports whether err is a network error worth retrying:
+// timeouts, failures to dial or read, reset or refused connections and
+// unexpected EOF. Context cancellation and deadlines, certificate errors and
+// invalid URLs are never retried
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if isCertificateError(err) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr.Op == "dial" || opErr.Op == "read"
+	}
+
+	return false
+}
+
+// isCertificateError reports whether err comes from the verification of the
+// server certificate, which fails the same way on every attempt
+func isCertificateError(err error) bool {
+	var verificationErr *tls.CertificateVerificationError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+
+	return errors.As(err, &verificationErr) ||
+		errors.As(err, &unknownAuthorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidErr)
+}
+
+// allowsMethod reports whether requests with the given method may be retried
+func (p *RetryPolicy) allowsMethod(method string) bool {
+	return slices.Contains(p.Methods, method)
+}
+
+// shouldRetry reports whether an attempt that ended with res or err should be retried
+func (p *RetryPolicy) shouldRetry(res *http.Response, err error) bool {
+	if err != nil {
+		retryable := p.RetryableError
+		if retryable == nil {
+			retryable = IsRetryableError
+		}
+		return retryable(err)
+	}
+
+	return slices.Contains(p.RetryableStatusCodes, res.StatusCode)
+}
+
+// backoff returns the delay to wait after the given attempt (starting at 1),
+// never longer than MaxBackoff
+func (p *RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if p.RespectRetryAfter && res != nil {
+		if delay, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+				delay = p.MaxBackoff
+			}
+			return delay
+		}
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		delay -= delay * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for the given delay or until ctx is done
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,376 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fastRetryPolicy returns the default policy with delays short enough for tests
+func fastRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestDoRequestRetry(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		body             string
+		statuses         []int
+		policy           func() *RetryPolicy
+		expectError      bool
+		expectedAttempts int32
+	}{
+		{
+			name:             "retries bad gateway until success",
+			method:           "GET",
+			statuses:         []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			policy:           fastRetryPolicy,
+			expectError:      false,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "gives up after max attempts",
+			method:           "GET",
+			statuses:         []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			policy:           fastRetryPolicy,
+			expectError:      true,
+			expectedAttempts: 4,
+		},
+		{
+			name:             "does not retry non retryable status",
+			method:           "GET",
+			statuses:         []int{http.StatusNotFound, http.StatusOK},
+			policy:           fastRetryPolicy,
+			expectError:      true,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "does not retry post by default",
+			method:           "POST",
+			body:             `{"name":"test"}`,
+			statuses:         []int{http.StatusBadGateway, http.StatusOK},
+			policy:           fastRetryPolicy,
+			expectError:      true,
+			expectedAttempts: 1,
+		},
+		{
+			name:     "retries post when opted in",
+			method:   "POST",
+			body:     `{"name":"test"}`,
+			statuses: []int{http.StatusBadGateway, http.StatusOK},
+			policy: func() *RetryPolicy {
+				policy := fastRetryPolicy()
+				policy.Methods = append(policy.Methods, http.MethodPost)
+				return policy
+			},
+			expectError:      false,
+			expectedAttempts: 2,
+		},
+		{
+			name:             "no retries without policy",
+			method:           "GET",
+			statuses:         []int{http.StatusBadGateway, http.StatusOK},
+			policy:           func() *RetryPolicy { return nil },
+			expectError:      true,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := atomic.AddInt32(&attempts, 1)
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, tt.body, string(body))
+				w.WriteHeader(tt.statuses[attempt-1])
+				w.Write([]byte(`{"status":"ok"}`))
+			}))
+			defer server.Close()
+
+			host := server.URL
+			token := "test"
+
+			client, _ := NewClient(&host, &token)
+			client.RetryPolicy = tt.policy()
+
+			var reqBody io.Reader
+			if tt.body != "" {
+				reqBody = strings.NewReader(tt.body)
+			}
+			req, _ := http.NewRequest(tt.method, server.URL, reqBody)
+			_, err := client.DoRequest(req)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedAttempts, atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func TestDoRequestRetryAfter(t *testing.T) {
+	var attempts int32
+	var first time.Time
+	var second time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		second = time.Now()
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	host := server.URL
+	token := "test"
+
+	client, _ := NewClient(&host, &token)
+	client.RetryPolicy = fastRetryPolicy()
+	client.RetryPolicy.MaxBackoff = 2 * time.Second
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	_, err := client.DoRequest(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+	assert.GreaterOrEqual(t, second.Sub(first), 900*time.Millisecond)
+}
+
+func TestDoRequestRetryAfterAboveMaxBackoff(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	host := server.URL
+	token := "test"
+
+	client, _ := NewClient(&host, &token)
+	client.RetryPolicy = fastRetryPolicy()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	start := time.Now()
+	_, err := client.DoRequest(req)
+
+	// the delay asked by the server is capped by MaxBackoff
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestDoRequestRetryContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	host := server.URL
+	token := "test"
+
+	client, _ := NewClient(&host, &token)
+	client.RetryPolicy = DefaultRetryPolicy()
+	client.RetryPolicy.InitialBackoff = time.Hour
+	client.RetryPolicy.MaxBackoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	_, err := client.DoRequestContext(ctx, req)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		expectOk   bool
+		expectedGE time.Duration
+		expectedLE time.Duration
+	}{
+		{
+			name:       "seconds",
+			value:      "3",
+			expectOk:   true,
+			expectedGE: 3 * time.Second,
+			expectedLE: 3 * time.Second,
+		},
+		{
+			name:       "http date",
+			value:      time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat),
+			expectOk:   true,
+			expectedGE: 8 * time.Second,
+			expectedLE: 10 * time.Second,
+		},
+		{
+			name:       "date in the past",
+			value:      time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat),
+			expectOk:   true,
+			expectedGE: 0,
+			expectedLE: 0,
+		},
+		{
+			name:     "empty",
+			value:    "",
+			expectOk: false,
+		},
+		{
+			name:     "invalid",
+			value:    "soon",
+			expectOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := parseRetryAfter(tt.value)
+			assert.Equal(t, tt.expectOk, ok)
+			if tt.expectOk {
+				assert.GreaterOrEqual(t, delay, tt.expectedGE)
+				assert.LessOrEqual(t, delay, tt.expectedLE)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
+		Multiplier:     2,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1, nil))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2, nil))
+	assert.Equal(t, 300*time.Millisecond, policy.backoff(3, nil))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		delay := policy.backoff(2, nil)
+		assert.GreaterOrEqual(t, delay, 100*time.Millisecond)
+		assert.LessOrEqual(t, delay, 200*time.Millisecond)
+	}
+
+	policy.Jitter = 0
+	policy.RespectRetryAfter = true
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"0"}}}
+	assert.Equal(t, time.Duration(0), policy.backoff(1, res))
+
+	res.Header.Set("Retry-After", "3600")
+	assert.Equal(t, 300*time.Millisecond, policy.backoff(1, res))
+}
+
+// timeoutError is a net.Error that reports a timeout
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsRetryableError(t *testing.T) {
+	urlError := func(err error) error {
+		return &url.Error{Op: "Get", URL: "https://n8n.example.com/api/v1/workflows", Err: err}
+	}
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "context canceled", err: urlError(context.Canceled), expected: false},
+		{name: "deadline exceeded", err: urlError(context.DeadlineExceeded), expected: false},
+		{name: "generic error", err: errors.New("boom"), expected: false},
+		{name: "unexpected eof", err: urlError(io.ErrUnexpectedEOF), expected: true},
+		{name: "eof", err: urlError(io.EOF), expected: true},
+		{name: "timeout", err: urlError(timeoutError{}), expected: true},
+		{
+			name:     "connection refused",
+			err:      urlError(&net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}),
+			expected: true,
+		},
+		{
+			name:     "connection reset",
+			err:      urlError(&net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}),
+			expected: true,
+		},
+		{
+			name:     "dial error",
+			err:      urlError(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "n8n.example.com"}}),
+			expected: true,
+		},
+		{
+			name:     "unknown authority",
+			err:      urlError(&tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}),
+			expected: false,
+		},
+		{
+			name:     "hostname mismatch",
+			err:      urlError(x509.HostnameError{Host: "n8n.example.com", Certificate: &x509.Certificate{}}),
+			expected: false,
+		},
+		{
+			name:     "unsupported protocol scheme",
+			err:      urlError(errors.New(`unsupported protocol scheme "ftp"`)),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsRetryableError(tt.err))
+		})
+	}
+}
+
+func TestDoRequestDoesNotRetryCertificateErrors(t *testing.T) {
+	var attempts int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, _ := New(server.URL, "test")
+	client.RetryPolicy = fastRetryPolicy()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	_, err := client.DoRequest(req)
+
+	assert.Error(t, err)
+	assert.False(t, IsRetryableError(err))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&attempts))
+}
@@ -0,0 +1,163 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+)
+
+type Credentials struct {
+	Client *client.Client
+}
+
+type N8nCredential struct {
+	Id        string                 `json:"id,omitempty"`
+	Name      string                 `json:"name"`
+	Type      string                 `json:"type"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	CreatedAt string                 `json:"createdAt,omitempty"`
+	UpdatedAt string                 `json:"updatedAt,omitempty"`
+}
+
+func NewCredentials(client *client.Client) *Credentials {
+	return &Credentials{Client: client}
+}
+
+// CreateCredential validates the credential data against the schema of its type
+// and creates it. Validation failures are returned as ValidationErrors before
+// anything is sent
+func (c *Credentials) CreateCredential(credential N8nCredential) (N8nCredential, error) {
+	return c.CreateCredentialContext(context.Background(), credential)
+}
+
+// CreateCredentialContext is like CreateCredential but binds every request to ctx
+func (c *Credentials) CreateCredentialContext(ctx context.Context, credential N8nCredential) (N8nCredential, error) {
+	if credential.Name == "" {
+		return N8nCredential{}, fmt.Errorf("name should not be empty when creating a credential")
+	}
+
+	if credential.Type == "" {
+		return N8nCredential{}, fmt.Errorf("type should not be empty when creating a credential")
+	}
+
+	schema, err := c.GetCredentialSchemaContext(ctx, credential.Type)
+	if err != nil {
+		return N8nCredential{}, err
+	}
+
+	if err := schema.Validate(credential.Data); err != nil {
+		return N8nCredential{}, err
+	}
+
+	// remove readonly fields
+	credential.Id = ""
+	credential.CreatedAt = ""
+	credential.UpdatedAt = ""
+	if credential.Data == nil {
+		credential.Data = map[string]interface{}{}
+	}
+
+	jsonCredential, err := json.Marshal(credential)
+	if err != nil {
+		return N8nCredential{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/credentials", c.Client.HostURL), bytes.NewReader(jsonCredential))
+	if err != nil {
+		return N8nCredential{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.Client.DoRequest(req)
+
+	if err != nil {
+		return N8nCredential{}, err
+	}
+
+	var created N8nCredential
+	err = json.Unmarshal(resp, &created)
+
+	if err != nil {
+		return N8nCredential{}, err
+	}
+
+	return created, nil
+}
+
+// DeleteCredential deletes a credential by its ID
+func (c *Credentials) DeleteCredential(id string) (bool, error) {
+	return c.DeleteCredentialContext(context.Background(), id)
+}
+
+// DeleteCredentialContext is like DeleteCredential but binds the request to ctx
+func (c *Credentials) DeleteCredentialContext(ctx context.Context, id string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/credentials/%s", c.Client.HostURL, url.PathEscape(id)), nil)
+	if err != nil {
+		return false, err
+	}
+	_, err = c.Client.DoRequest(req)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetCredentialSchema retrieves the JSON schema of the data of a credential type
+func (c *Credentials) GetCredentialSchema(credentialType string) (N8nCredentialSchema, error) {
+	return c.GetCredentialSchemaContext(context.Background(), credentialType)
+}
+
+// GetCredentialSchemaContext is like GetCredentialSchema but binds the request to ctx
+func (c *Credentials) GetCredentialSchemaContext(ctx context.Context, credentialType string) (N8nCredentialSchema, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/credentials/schema/%s", c.Client.HostURL, url.PathEscape(credentialType)), nil)
+	if err != nil {
+		return N8nCredentialSchema{}, err
+	}
+	resp, err := c.Client.DoRequest(req)
+
+	if err != nil {
+		return N8nCredentialSchema{}, err
+	}
+
+	var schema N8nCredentialSchema
+	err = json.Unmarshal(resp, &schema)
+
+	if err != nil {
+		return N8nCredentialSchema{}, err
+	}
+
+	return schema, nil
+}
+
+// TransferCredential moves a credential to another project
+func (c *Credentials) TransferCredential(id, destinationProjectId string) (bool, error) {
+	return c.TransferCredentialContext(context.Background(), id, destinationProjectId)
+}
+
+// TransferCredentialContext is like TransferCredential but binds the request to ctx
+func (c *Credentials) TransferCredentialContext(ctx context.Context, id, destinationProjectId string) (bool, error) {
+	payload, err := json.Marshal(map[string]string{"destinationProjectId": destinationProjectId})
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/credentials/%s/transfer", c.Client.HostURL, url.PathEscape(id)), bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = c.Client.DoRequest(req)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
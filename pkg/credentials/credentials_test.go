@@ -0,0 +1,185 @@
+package credentials
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// httpHeaderAuthSchema mirrors the schema n8n returns for the httpHeaderAuth type
+const httpHeaderAuthSchema = `{
+	"additionalProperties": false,
+	"type": "object",
+	"properties": {
+		"name": {"type": "string"},
+		"value": {"type": "string"}
+	},
+	"required": ["name", "value"]
+}`
+
+func TestNewCredentials(t *testing.T) {
+	c := &client.Client{}
+	credentials := NewCredentials(c)
+	assert.NotNil(t, credentials)
+	assert.Equal(t, c, credentials.Client)
+}
+
+func TestCreateCredential(t *testing.T) {
+	tests := []struct {
+		name          string
+		credential    N8nCredential
+		expectError   bool
+		expectFields  []string
+		expectCreated bool
+	}{
+		{
+			name: "successful create credential",
+			credential: N8nCredential{
+				Name: "API header",
+				Type: "httpHeaderAuth",
+				Data: map[string]interface{}{"name": "X-API-KEY", "value": "secret"},
+			},
+			expectError:   false,
+			expectCreated: true,
+		},
+		{
+			name: "invalid data is rejected before sending",
+			credential: N8nCredential{
+				Name: "API header",
+				Type: "httpHeaderAuth",
+				Data: map[string]interface{}{"name": 42, "token": "secret"},
+			},
+			expectError:   true,
+			expectFields:  []string{"value", "name", "token"},
+			expectCreated: false,
+		},
+		{
+			name: "missing type",
+			credential: N8nCredential{
+				Name: "API header",
+			},
+			expectError:   true,
+			expectCreated: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "GET" && r.URL.Path == "/credentials/schema/httpHeaderAuth":
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(httpHeaderAuthSchema))
+				case r.Method == "POST" && r.URL.Path == "/credentials":
+					created = true
+					body, _ := io.ReadAll(r.Body)
+					var credential N8nCredential
+					json.Unmarshal(body, &credential)
+					credential.Id = "cred1"
+					credential.Data = nil
+					w.WriteHeader(http.StatusOK)
+					json.NewEncoder(w).Encode(credential)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			credentials := NewCredentials(c)
+
+			credential, err := credentials.CreateCredential(tt.credential)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.expectFields != nil {
+					var validationErrs ValidationErrors
+					if assert.True(t, errors.As(err, &validationErrs)) {
+						var fields []string
+						for _, validationErr := range validationErrs {
+							fields = append(fields, validationErr.Field)
+						}
+						assert.Equal(t, tt.expectFields, fields)
+					}
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "cred1", credential.Id)
+				assert.Equal(t, tt.credential.Name, credential.Name)
+			}
+			assert.Equal(t, tt.expectCreated, created)
+		})
+	}
+}
+
+func TestDeleteCredential(t *testing.T) {
+	tests := []struct {
+		name        string
+		server      *httptest.Server
+		id          string
+		expectError bool
+	}{
+		{
+			name: "successful delete credential",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "DELETE", r.Method)
+				assert.Equal(t, "/credentials/cred1", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+			})),
+			id:          "cred1",
+			expectError: false,
+		},
+		{
+			name: "credential not found",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			})),
+			id:          "missing",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer tt.server.Close()
+
+			c, _ := client.New(tt.server.URL, "test")
+			credentials := NewCredentials(c)
+
+			success, err := credentials.DeleteCredential(tt.id)
+
+			if tt.expectError {
+				assert.ErrorIs(t, err, client.ErrNotFound)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, success)
+			}
+		})
+	}
+}
+
+func TestTransferCredential(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/credentials/cred1/transfer", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"destinationProjectId":"project2"}`, string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	credentials := NewCredentials(c)
+
+	success, err := credentials.TransferCredential("cred1", "project2")
+
+	assert.NoError(t, err)
+	assert.True(t, success)
+}
@@ -0,0 +1,187 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// N8nCredentialSchema is the subset of JSON schema used by n8n to describe the
+// data of a credential type
+type N8nCredentialSchema struct {
+	Type                 string                          `json:"type,omitempty"`
+	Properties           map[string]*N8nCredentialSchema `json:"properties,omitempty"`
+	Required             []string                        `json:"required,omitempty"`
+	AdditionalProperties json.RawMessage                 `json:"additionalProperties,omitempty"`
+	Enum                 []interface{}                   `json:"enum,omitempty"`
+	AllOf                []*N8nCredentialSchema          `json:"allOf,omitempty"`
+	If                   *N8nCredentialSchema            `json:"if,omitempty"`
+	Then                 *N8nCredentialSchema            `json:"then,omitempty"`
+	Else                 *N8nCredentialSchema            `json:"else,omitempty"`
+	Not                  *N8nCredentialSchema            `json:"not,omitempty"`
+}
+
+// ValidationError describes why a single field of the credential data is invalid
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationErrors holds every field level error found while validating credential data
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, validationError := range e {
+		messages = append(messages, validationError.Error())
+	}
+	return fmt.Sprintf("invalid credential data: %s", strings.Join(messages, "; "))
+}
+
+// Validate checks data against the schema and returns ValidationErrors listing
+// every invalid field, or nil when data is valid
+func (s N8nCredentialSchema) Validate(data map[string]interface{}) error {
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	// round trip the data so numbers and nested values have their JSON types
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("invalid credential data: %v", err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return fmt.Errorf("invalid credential data: %v", err)
+	}
+
+	errs := s.validate("", value)
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// validate returns the errors found for value located at field
+func (s *N8nCredentialSchema) validate(field string, value interface{}) ValidationErrors {
+	var errs ValidationErrors
+
+	if s.Type != "" && !matchesType(s.Type, value) {
+		return ValidationErrors{{Field: field, Message: fmt.Sprintf("should be of type %s", s.Type)}}
+	}
+
+	if len(s.Enum) > 0 && !matchesEnum(s.Enum, value) {
+		errs = append(errs, ValidationError{Field: field, Message: fmt.Sprintf("should be one of %v", s.Enum)})
+	}
+
+	if object, ok := value.(map[string]interface{}); ok {
+		errs = append(errs, s.validateObject(field, object)...)
+	}
+
+	for _, subSchema := range s.AllOf {
+		errs = append(errs, subSchema.validate(field, value)...)
+	}
+
+	if s.If != nil {
+		if len(s.If.validate(field, value)) == 0 {
+			if s.Then != nil {
+				errs = append(errs, s.Then.validate(field, value)...)
+			}
+		} else if s.Else != nil {
+			errs = append(errs, s.Else.validate(field, value)...)
+		}
+	}
+
+	if s.Not != nil && len(s.Not.validate(field, value)) == 0 {
+		errs = append(errs, ValidationError{Field: field, Message: "should not match the excluded schema"})
+	}
+
+	return errs
+}
+
+// validateObject applies the object keywords of the schema
+func (s *N8nCredentialSchema) validateObject(field string, object map[string]interface{}) ValidationErrors {
+	var errs ValidationErrors
+
+	for _, required := range s.Required {
+		if _, ok := object[required]; !ok {
+			errs = append(errs, ValidationError{Field: joinField(field, required), Message: "is required"})
+		}
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		property, ok := s.Properties[key]
+		if !ok {
+			if string(s.AdditionalProperties) == "false" {
+				errs = append(errs, ValidationError{Field: joinField(field, key), Message: "is not a known property"})
+			}
+			continue
+		}
+		errs = append(errs, property.validate(joinField(field, key), object[key])...)
+	}
+
+	return errs
+}
+
+// matchesType reports whether a decoded JSON value has the given schema type
+func matchesType(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "number":
+		_, ok := value.(float64)
+		return ok
+	case "integer":
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "null":
+		return value == nil
+	}
+
+	// unknown types are not enforced
+	return true
+}
+
+// matchesEnum reports whether value is one of the allowed values
+func matchesEnum(enum []interface{}, value interface{}) bool {
+	for _, allowed := range enum {
+		if reflect.DeepEqual(allowed, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinField(parent, field string) string {
+	if parent == "" {
+		return field
+	}
+	return parent + "." + field
+}
@@ -0,0 +1,88 @@
+package credentials
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// conditionalSchema requires the host only when a custom host is used, like
+// the schemas n8n generates for credential types with displayOptions
+const conditionalSchema = `{
+	"additionalProperties": false,
+	"type": "object",
+	"properties": {
+		"useCustomHost": {"type": "boolean"},
+		"host": {"type": "string"},
+		"region": {"type": "string", "enum": ["eu", "us"]},
+		"port": {"type": "integer"}
+	},
+	"allOf": [
+		{
+			"if": {"properties": {"useCustomHost": {"enum": [true]}}},
+			"then": {"allOf": [{"required": ["host"]}]},
+			"else": {"allOf": [{"required": ["region"]}]}
+		}
+	]
+}`
+
+func TestSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           map[string]interface{}
+		expectedErrors ValidationErrors
+	}{
+		{
+			name: "custom host given",
+			data: map[string]interface{}{"useCustomHost": true, "host": "https://example.com"},
+		},
+		{
+			name:           "custom host missing",
+			data:           map[string]interface{}{"useCustomHost": true},
+			expectedErrors: ValidationErrors{{Field: "host", Message: "is required"}},
+		},
+		{
+			name: "region used without custom host",
+			data: map[string]interface{}{"useCustomHost": false, "region": "eu", "port": 443},
+		},
+		{
+			name: "invalid values",
+			data: map[string]interface{}{"useCustomHost": false, "region": "asia", "port": 1.5, "extra": true},
+			expectedErrors: ValidationErrors{
+				{Field: "extra", Message: "is not a known property"},
+				{Field: "port", Message: "should be of type integer"},
+				{Field: "region", Message: "should be one of [eu us]"},
+			},
+		},
+	}
+
+	var schema N8nCredentialSchema
+	assert.NoError(t, json.Unmarshal([]byte(conditionalSchema), &schema))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate(tt.data)
+
+			if tt.expectedErrors == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErrs ValidationErrors
+			if assert.True(t, errors.As(err, &validationErrs)) {
+				assert.Equal(t, tt.expectedErrors, validationErrs)
+			}
+		})
+	}
+}
+
+func TestValidationErrorsMessage(t *testing.T) {
+	err := ValidationErrors{
+		{Field: "host", Message: "is required"},
+		{Field: "port", Message: "should be of type integer"},
+	}
+
+	assert.Equal(t, "invalid credential data: host: is required; port: should be of type integer", err.Error())
+}
@@ -0,0 +1,254 @@
+package executions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+)
+
+type Executions struct {
+	Client *client.Client
+}
+
+// ExecutionStatus is the state of an execution
+type ExecutionStatus string
+
+const (
+	StatusCanceled ExecutionStatus = "canceled"
+	StatusCrashed  ExecutionStatus = "crashed"
+	StatusError    ExecutionStatus = "error"
+	StatusNew      ExecutionStatus = "new"
+	StatusRunning  ExecutionStatus = "running"
+	StatusSuccess  ExecutionStatus = "success"
+	StatusUnknown  ExecutionStatus = "unknown"
+	StatusWaiting  ExecutionStatus = "waiting"
+)
+
+// ExecutionMode describes what started an execution
+type ExecutionMode string
+
+const (
+	ModeCli        ExecutionMode = "cli"
+	ModeError      ExecutionMode = "error"
+	ModeIntegrated ExecutionMode = "integrated"
+	ModeInternal   ExecutionMode = "internal"
+	ModeManual     ExecutionMode = "manual"
+	ModeRetry      ExecutionMode = "retry"
+	ModeTrigger    ExecutionMode = "trigger"
+	ModeWebhook    ExecutionMode = "webhook"
+	ModeEvaluation ExecutionMode = "evaluation"
+	ModeChat       ExecutionMode = "chat"
+)
+
+type N8nExecution struct {
+	Id             string            `json:"id"`
+	Finished       bool              `json:"finished"`
+	Mode           ExecutionMode     `json:"mode"`
+	Status         ExecutionStatus   `json:"status"`
+	RetryOf        string            `json:"retryOf,omitempty"`
+	RetrySuccessId string            `json:"retrySuccessId,omitempty"`
+	StartedAt      time.Time         `json:"startedAt"`
+	StoppedAt      *time.Time        `json:"stoppedAt,omitempty"`
+	WaitTill       *time.Time        `json:"waitTill,omitempty"`
+	WorkflowId     string            `json:"workflowId"`
+	CustomData     map[string]string `json:"customData,omitempty"`
+	// Data holds the raw run data, only returned when includeData is requested
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// N8nExecutionList is a single page of executions
+type N8nExecutionList struct {
+	Data       []N8nExecution `json:"data"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+}
+
+// ListExecutionsOptions filters the executions returned by ListExecutions
+type ListExecutionsOptions struct {
+	WorkflowId  string
+	Status      ExecutionStatus
+	ProjectId   string
+	IncludeData bool
+	Limit       int
+	Cursor      string
+}
+
+// UnmarshalJSON accepts ids sent either as numbers or as strings, as n8n has used both
+func (e *N8nExecution) UnmarshalJSON(data []byte) error {
+	type execution N8nExecution
+	aux := struct {
+		*execution
+		Id             json.RawMessage `json:"id"`
+		RetryOf        json.RawMessage `json:"retryOf"`
+		RetrySuccessId json.RawMessage `json:"retrySuccessId"`
+		WorkflowId     json.RawMessage `json:"workflowId"`
+	}{execution: (*execution)(e)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if e.Id, err = idToString(aux.Id); err != nil {
+		return err
+	}
+	if e.RetryOf, err = idToString(aux.RetryOf); err != nil {
+		return err
+	}
+	if e.RetrySuccessId, err = idToString(aux.RetrySuccessId); err != nil {
+		return err
+	}
+	if e.WorkflowId, err = idToString(aux.WorkflowId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// idToString converts a JSON string, number or null id into a string
+func idToString(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	var id string
+	if err := json.Unmarshal(raw, &id); err == nil {
+		return id, nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err != nil {
+		return "", fmt.Errorf("invalid id %s: %v", raw, err)
+	}
+
+	return number.String(), nil
+}
+
+func NewExecutions(client *client.Client) *Executions {
+	return &Executions{Client: client}
+}
+
+// ListExecutions retrieves a single page of executions matching the options
+func (e *Executions) ListExecutions(opts ListExecutionsOptions) (N8nExecutionList, error) {
+	return e.ListExecutionsContext(context.Background(), opts)
+}
+
+// ListExecutionsContext is like ListExecutions but binds the request to ctx
+func (e *Executions) ListExecutionsContext(ctx context.Context, opts ListExecutionsOptions) (N8nExecutionList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/executions?%s", e.Client.HostURL, opts.query().Encode()), nil)
+	if err != nil {
+		return N8nExecutionList{}, err
+	}
+	resp, err := e.Client.DoRequest(req)
+
+	if err != nil {
+		return N8nExecutionList{}, err
+	}
+
+	var list N8nExecutionList
+	err = json.Unmarshal(resp, &list)
+
+	if err != nil {
+		return N8nExecutionList{}, err
+	}
+
+	return list, nil
+}
+
+// AllExecutions iterates over every execution matching the options, starting at
+// opts.Cursor and requesting pages of opts.Limit executions as the iteration advances
+func (e *Executions) AllExecutions(ctx context.Context, opts ListExecutionsOptions) iter.Seq2[N8nExecution, error] {
+	pageOpts := opts
+	pageOpts.Limit = 0
+	pageOpts.Cursor = ""
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/executions?%s", e.Client.HostURL, pageOpts.query().Encode()), nil)
+	if err != nil {
+		return func(yield func(N8nExecution, error) bool) {
+			yield(N8nExecution{}, err)
+		}
+	}
+
+	pager := e.Client.NewPager(req)
+	pager.Cursor = opts.Cursor
+	pager.Limit = opts.Limit
+
+	return client.Items[N8nExecution](ctx, pager)
+}
+
+// GetExecution retrieves an execution by its ID, including its run data when includeData is true
+func (e *Executions) GetExecution(id string, includeData bool) (N8nExecution, error) {
+	return e.GetExecutionContext(context.Background(), id, includeData)
+}
+
+// GetExecutionContext is like GetExecution but binds the request to ctx
+func (e *Executions) GetExecutionContext(ctx context.Context, id string, includeData bool) (N8nExecution, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/executions/%s?includeData=%t", e.Client.HostURL, url.PathEscape(id), includeData), nil)
+	if err != nil {
+		return N8nExecution{}, err
+	}
+	resp, err := e.Client.DoRequest(req)
+
+	if err != nil {
+		return N8nExecution{}, err
+	}
+
+	var execution N8nExecution
+	err = json.Unmarshal(resp, &execution)
+
+	if err != nil {
+		return N8nExecution{}, err
+	}
+
+	return execution, nil
+}
+
+// DeleteExecution deletes an execution by its ID
+func (e *Executions) DeleteExecution(id string) (bool, error) {
+	return e.DeleteExecutionContext(context.Background(), id)
+}
+
+// DeleteExecutionContext is like DeleteExecution but binds the request to ctx
+func (e *Executions) DeleteExecutionContext(ctx context.Context, id string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/executions/%s", e.Client.HostURL, url.PathEscape(id)), nil)
+	if err != nil {
+		return false, err
+	}
+	_, err = e.Client.DoRequest(req)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// query builds the query string of a list request
+func (o ListExecutionsOptions) query() url.Values {
+	q := url.Values{}
+	if o.WorkflowId != "" {
+		q.Set("workflowId", o.WorkflowId)
+	}
+	if o.Status != "" {
+		q.Set("status", string(o.Status))
+	}
+	if o.ProjectId != "" {
+		q.Set("projectId", o.ProjectId)
+	}
+	if o.IncludeData {
+		q.Set("includeData", "true")
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Cursor != "" {
+		q.Set("cursor", o.Cursor)
+	}
+	return q
+}
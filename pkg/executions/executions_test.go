@@ -0,0 +1,240 @@
+package executions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewExecutions(t *testing.T) {
+	c := &client.Client{}
+	e := NewExecutions(c)
+	assert.NotNil(t, e)
+	assert.Equal(t, c, e.Client)
+}
+
+func TestListExecutions(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          ListExecutionsOptions
+		expectedQuery map[string]string
+		expectError   bool
+	}{
+		{
+			name: "all filters",
+			opts: ListExecutionsOptions{
+				WorkflowId:  "wf1",
+				Status:      StatusError,
+				ProjectId:   "p1",
+				IncludeData: true,
+				Limit:       50,
+				Cursor:      "abc",
+			},
+			expectedQuery: map[string]string{
+				"workflowId":  "wf1",
+				"status":      "error",
+				"projectId":   "p1",
+				"includeData": "true",
+				"limit":       "50",
+				"cursor":      "abc",
+			},
+			expectError: false,
+		},
+		{
+			name:          "no filters",
+			opts:          ListExecutionsOptions{},
+			expectedQuery: map[string]string{},
+			expectError:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/executions", r.URL.Path)
+				assert.Len(t, r.URL.Query(), len(tt.expectedQuery))
+				for key, value := range tt.expectedQuery {
+					assert.Equal(t, value, r.URL.Query().Get(key))
+				}
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{
+					"data": [
+						{
+							"id": 1000,
+							"finished": false,
+							"mode": "trigger",
+							"retryOf": null,
+							"retrySuccessId": "1001",
+							"startedAt": "2024-05-01T10:00:00.000Z",
+							"stoppedAt": "2024-05-01T10:00:01.500Z",
+							"workflowId": "wf1",
+							"waitTill": null,
+							"status": "error"
+						}
+					],
+					"nextCursor": "next"
+				}`))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			e := NewExecutions(c)
+
+			list, err := e.ListExecutions(tt.opts)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "next", list.NextCursor)
+				assert.Len(t, list.Data, 1)
+				execution := list.Data[0]
+				assert.Equal(t, "1000", execution.Id)
+				assert.Equal(t, ModeTrigger, execution.Mode)
+				assert.Equal(t, StatusError, execution.Status)
+				assert.Equal(t, "", execution.RetryOf)
+				assert.Equal(t, "1001", execution.RetrySuccessId)
+				assert.Equal(t, "wf1", execution.WorkflowId)
+				assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), execution.StartedAt)
+				if assert.NotNil(t, execution.StoppedAt) {
+					assert.Equal(t, 1500*time.Millisecond, execution.StoppedAt.Sub(execution.StartedAt))
+				}
+				assert.Nil(t, execution.WaitTill)
+			}
+		})
+	}
+}
+
+func TestAllExecutions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "wf1", r.URL.Query().Get("workflowId"))
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Query().Get("cursor") {
+		case "start":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data":       []map[string]interface{}{{"id": 1, "status": "success", "startedAt": "2024-05-01T10:00:00Z"}},
+				"nextCursor": "second",
+			})
+		case "second":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data":       []map[string]interface{}{{"id": 2, "status": "error", "startedAt": "2024-05-01T10:00:00Z"}},
+				"nextCursor": nil,
+			})
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	e := NewExecutions(c)
+
+	var ids []string
+	for execution, err := range e.AllExecutions(context.Background(), ListExecutionsOptions{WorkflowId: "wf1", Limit: 1, Cursor: "start"}) {
+		assert.NoError(t, err)
+		ids = append(ids, execution.Id)
+	}
+
+	assert.Equal(t, []string{"1", "2"}, ids)
+}
+
+func TestGetExecution(t *testing.T) {
+	tests := []struct {
+		name          string
+		server        *httptest.Server
+		id            string
+		includeData   bool
+		expectError   bool
+		expectErrorIs error
+	}{
+		{
+			name: "successful get execution",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/executions/42", r.URL.Path)
+				assert.Equal(t, "true", r.URL.Query().Get("includeData"))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"id":"42","mode":"manual","status":"success","startedAt":"2024-05-01T10:00:00Z","workflowId":"wf1","data":{"resultData":{}}}`))
+			})),
+			id:          "42",
+			includeData: true,
+			expectError: false,
+		},
+		{
+			name: "execution not found",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"Not Found"}`))
+			})),
+			id:            "404",
+			expectError:   true,
+			expectErrorIs: client.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer tt.server.Close()
+
+			c, _ := client.New(tt.server.URL, "test")
+			e := NewExecutions(c)
+
+			execution, err := e.GetExecution(tt.id, tt.includeData)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectErrorIs)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.id, execution.Id)
+				assert.Equal(t, ModeManual, execution.Mode)
+				assert.Equal(t, StatusSuccess, execution.Status)
+				assert.JSONEq(t, `{"resultData":{}}`, string(execution.Data))
+			}
+		})
+	}
+}
+
+func TestDeleteExecution(t *testing.T) {
+	tests := []struct {
+		name        string
+		server      *httptest.Server
+		id          string
+		expectError bool
+	}{
+		{
+			name: "successful delete execution",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "DELETE", r.Method)
+				assert.Equal(t, "/executions/42", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+			})),
+			id:          "42",
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer tt.server.Close()
+
+			c, _ := client.New(tt.server.URL, "test")
+			e := NewExecutions(c)
+
+			success, err := e.DeleteExecution(tt.id)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, success)
+			}
+		})
+	}
+}
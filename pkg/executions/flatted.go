@@ -0,0 +1,116 @@
+package executions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// DecodeFlatted decodes data serialized with the flatted format used by n8n to
+// store execution data and stores the result in v.
+//
+// A flatted document is a JSON array whose first entry is the root value. Every
+// string found inside an object or array of the document is the index of the
+// entry holding the actual value, while strings stored directly as entries are
+// plain strings
+func DecodeFlatted(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var input []interface{}
+	if err := decoder.Decode(&input); err != nil {
+		return fmt.Errorf("invalid flatted document: %v", err)
+	}
+	if len(input) == 0 {
+		return fmt.Errorf("invalid flatted document: empty array")
+	}
+
+	f := flatted{
+		input:    input,
+		resolved: make(map[int]interface{}),
+		visiting: make(map[int]bool),
+	}
+
+	root, err := f.resolve(0)
+	if err != nil {
+		return err
+	}
+
+	// the resolved tree only holds plain JSON values, round trip it into v
+	plain, err := json.Marshal(root)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(plain, v)
+}
+
+// flatted keeps the state of a single DecodeFlatted call
+type flatted struct {
+	input    []interface{}
+	resolved map[int]interface{}
+	visiting map[int]bool
+}
+
+// resolve returns the value stored at index with all its references replaced
+func (f *flatted) resolve(index int) (interface{}, error) {
+	if index < 0 || index >= len(f.input) {
+		return nil, fmt.Errorf("invalid flatted reference %d", index)
+	}
+
+	if value, ok := f.resolved[index]; ok {
+		return value, nil
+	}
+
+	if f.visiting[index] {
+		return nil, fmt.Errorf("circular flatted reference %d", index)
+	}
+	f.visiting[index] = true
+	defer delete(f.visiting, index)
+
+	var value interface{}
+	switch entry := f.input[index].(type) {
+	case map[string]interface{}:
+		object := make(map[string]interface{}, len(entry))
+		for key, item := range entry {
+			revived, err := f.revive(item)
+			if err != nil {
+				return nil, err
+			}
+			object[key] = revived
+		}
+		value = object
+	case []interface{}:
+		array := make([]interface{}, len(entry))
+		for i, item := range entry {
+			revived, err := f.revive(item)
+			if err != nil {
+				return nil, err
+			}
+			array[i] = revived
+		}
+		value = array
+	default:
+		// strings, numbers, booleans and null stored as entries are plain values
+		value = entry
+	}
+
+	f.resolved[index] = value
+	return value, nil
+}
+
+// revive resolves a value found inside an object or array entry
+func (f *flatted) revive(item interface{}) (interface{}, error) {
+	reference, ok := item.(string)
+	if !ok {
+		return item, nil
+	}
+
+	index, err := strconv.Atoi(reference)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flatted reference %q", reference)
+	}
+
+	return f.resolve(index)
+}
@@ -0,0 +1,96 @@
+package executions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrWaitTimeout is returned by WaitForExecution when the context ends before
+// the execution reaches a terminal status
+var ErrWaitTimeout = errors.New("timed out waiting for execution")
+
+// DefaultPollInterval is used by WaitForExecution when no interval is given
+const DefaultPollInterval = 2 * time.Second
+
+// IsTerminal reports whether an execution with this status has finished running
+func (s ExecutionStatus) IsTerminal() bool {
+	switch s {
+	case StatusSuccess, StatusError, StatusCrashed, StatusCanceled:
+		return true
+	}
+	return false
+}
+
+// RetryExecution retries a failed execution and returns the new execution. When
+// loadWorkflow is true the current version of the workflow is used instead of
+// the one stored with the execution
+func (e *Executions) RetryExecution(id string, loadWorkflow bool) (N8nExecution, error) {
+	return e.RetryExecutionContext(context.Background(), id, loadWorkflow)
+}
+
+// RetryExecutionContext is like RetryExecution but binds the request to ctx
+func (e *Executions) RetryExecutionContext(ctx context.Context, id string, loadWorkflow bool) (N8nExecution, error) {
+	payload, err := json.Marshal(map[string]bool{"loadWorkflow": loadWorkflow})
+	if err != nil {
+		return N8nExecution{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/executions/%s/retry", e.Client.HostURL, url.PathEscape(id)), bytes.NewReader(payload))
+	if err != nil {
+		return N8nExecution{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.Client.DoRequest(req)
+
+	if err != nil {
+		return N8nExecution{}, err
+	}
+
+	var execution N8nExecution
+	err = json.Unmarshal(resp, &execution)
+
+	if err != nil {
+		return N8nExecution{}, err
+	}
+
+	return execution, nil
+}
+
+// WaitForExecution polls an execution every pollInterval until it reaches a
+// terminal status and returns it. The wait is bounded by ctx, when it ends first
+// the returned error matches both ErrWaitTimeout and the context error
+func (e *Executions) WaitForExecution(ctx context.Context, id string, pollInterval time.Duration) (N8nExecution, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		execution, err := e.GetExecutionContext(ctx, id, false)
+		if err != nil {
+			if ctx.Err() != nil {
+				return N8nExecution{}, fmt.Errorf("execution %s: %w: %w", id, ErrWaitTimeout, ctx.Err())
+			}
+			return N8nExecution{}, err
+		}
+
+		if execution.Status.IsTerminal() {
+			return execution, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return execution, fmt.Errorf("execution %s still %s: %w: %w", id, execution.Status, ErrWaitTimeout, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package executions
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryExecution(t *testing.T) {
+	tests := []struct {
+		name         string
+		loadWorkflow bool
+		expectedBody string
+	}{
+		{
+			name:         "retry with stored workflow",
+			loadWorkflow: false,
+			expectedBody: `{"loadWorkflow":false}`,
+		},
+		{
+			name:         "retry with current workflow",
+			loadWorkflow: true,
+			expectedBody: `{"loadWorkflow":true}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/executions/10/retry", r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, tt.expectedBody, string(body))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"id":11,"mode":"retry","status":"running","retryOf":10,"startedAt":"2024-05-01T10:00:00Z","workflowId":"wf1"}`))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			e := NewExecutions(c)
+
+			execution, err := e.RetryExecution("10", tt.loadWorkflow)
+
+			assert.NoError(t, err)
+			assert.Equal(t, "11", execution.Id)
+			assert.Equal(t, "10", execution.RetryOf)
+			assert.Equal(t, ModeRetry, execution.Mode)
+		})
+	}
+}
+
+func TestWaitForExecution(t *testing.T) {
+	tests := []struct {
+		name             string
+		statuses         []ExecutionStatus
+		timeout          time.Duration
+		expectTimeout    bool
+		expectedStatus   ExecutionStatus
+		expectedRequests int32
+	}{
+		{
+			name:             "waits until success",
+			statuses:         []ExecutionStatus{StatusNew, StatusRunning, StatusSuccess},
+			timeout:          time.Second,
+			expectedStatus:   StatusSuccess,
+			expectedRequests: 3,
+		},
+		{
+			name:             "crashed is terminal",
+			statuses:         []ExecutionStatus{StatusCrashed},
+			timeout:          time.Second,
+			expectedStatus:   StatusCrashed,
+			expectedRequests: 1,
+		},
+		{
+			name:          "times out while running",
+			statuses:      []ExecutionStatus{StatusRunning},
+			timeout:       30 * time.Millisecond,
+			expectTimeout: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				request := int(atomic.AddInt32(&requests, 1))
+				status := tt.statuses[min(request, len(tt.statuses))-1]
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":        "10",
+					"status":    status,
+					"startedAt": "2024-05-01T10:00:00Z",
+				})
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			e := NewExecutions(c)
+
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			execution, err := e.WaitForExecution(ctx, "10", 5*time.Millisecond)
+
+			if tt.expectTimeout {
+				assert.ErrorIs(t, err, ErrWaitTimeout)
+				assert.ErrorIs(t, err, context.DeadlineExceeded)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, execution.Status)
+				assert.Equal(t, tt.expectedRequests, atomic.LoadInt32(&requests))
+			}
+		})
+	}
+}
+
+func TestExecutionStatusIsTerminal(t *testing.T) {
+	for _, status := range []ExecutionStatus{StatusSuccess, StatusError, StatusCrashed, StatusCanceled} {
+		assert.True(t, status.IsTerminal(), status)
+	}
+	for _, status := range []ExecutionStatus{StatusNew, StatusRunning, StatusWaiting, StatusUnknown} {
+		assert.False(t, status.IsTerminal(), status)
+	}
+}
@@ -0,0 +1,134 @@
+package executions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// N8nExecutionData is the decoded data of an execution
+type N8nExecutionData struct {
+	ResultData N8nResultData `json:"resultData"`
+}
+
+type N8nResultData struct {
+	RunData          N8nRunData         `json:"runData"`
+	LastNodeExecuted string             `json:"lastNodeExecuted,omitempty"`
+	Error            *N8nExecutionError `json:"error,omitempty"`
+}
+
+// N8nRunData holds every run of every executed node, keyed by node name
+type N8nRunData map[string][]N8nTaskData
+
+// N8nTaskData is a single run of a node
+type N8nTaskData struct {
+	// StartTime is the start of the run in milliseconds since the Unix epoch
+	StartTime int64 `json:"startTime"`
+	// ExecutionTime is the duration of the run in milliseconds
+	ExecutionTime   int64              `json:"executionTime"`
+	ExecutionStatus string             `json:"executionStatus,omitempty"`
+	Source          []*N8nTaskSource   `json:"source,omitempty"`
+	Data            N8nTaskOutputs     `json:"data,omitempty"`
+	Error           *N8nExecutionError `json:"error,omitempty"`
+}
+
+// N8nTaskOutputs holds the items of every output of a run, keyed by
+// connection type (main, ai_tool...) and indexed by output
+type N8nTaskOutputs map[string][][]N8nItem
+
+type N8nTaskSource struct {
+	PreviousNode       string `json:"previousNode"`
+	PreviousNodeOutput int    `json:"previousNodeOutput,omitempty"`
+	PreviousNodeRun    int    `json:"previousNodeRun,omitempty"`
+}
+
+// N8nItem is a single item produced by a node
+type N8nItem struct {
+	Json   map[string]interface{}   `json:"json"`
+	Binary map[string]N8nBinaryData `json:"binary,omitempty"`
+	Error  *N8nExecutionError       `json:"error,omitempty"`
+}
+
+// N8nBinaryData describes a binary property of an item. Data is the base64
+// content, or a storage marker when binary data is kept outside the database
+type N8nBinaryData struct {
+	Id            string `json:"id,omitempty"`
+	Data          string `json:"data,omitempty"`
+	MimeType      string `json:"mimeType"`
+	FileType      string `json:"fileType,omitempty"`
+	FileName      string `json:"fileName,omitempty"`
+	FileExtension string `json:"fileExtension,omitempty"`
+	FileSize      string `json:"fileSize,omitempty"`
+	Directory     string `json:"directory,omitempty"`
+}
+
+type N8nExecutionError struct {
+	Name        string        `json:"name,omitempty"`
+	Message     string        `json:"message"`
+	Description string        `json:"description,omitempty"`
+	Stack       string        `json:"stack,omitempty"`
+	Node        *N8nErrorNode `json:"node,omitempty"`
+}
+
+type N8nErrorNode struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Started returns the start of the run
+func (t N8nTaskData) Started() time.Time {
+	return time.UnixMilli(t.StartTime)
+}
+
+// Duration returns how long the run took
+func (t N8nTaskData) Duration() time.Duration {
+	return time.Duration(t.ExecutionTime) * time.Millisecond
+}
+
+// Output returns the items of the given main output of the last run of a node
+func (r N8nRunData) Output(nodeName string, outputIndex int) ([]N8nItem, error) {
+	runs, ok := r[nodeName]
+	if !ok || len(runs) == 0 {
+		return nil, fmt.Errorf("node %s has no run data", nodeName)
+	}
+
+	outputs := runs[len(runs)-1].Data["main"]
+	if outputIndex < 0 || outputIndex >= len(outputs) {
+		return nil, fmt.Errorf("node %s has no output %d", nodeName, outputIndex)
+	}
+
+	return outputs[outputIndex], nil
+}
+
+// ExecutionData decodes the run data of an execution fetched with includeData.
+// Data may be sent in the flatted format, either as an array or as a string
+// holding it, or as a plain object
+func (e N8nExecution) ExecutionData() (N8nExecutionData, error) {
+	var data N8nExecutionData
+
+	raw := bytes.TrimSpace(e.Data)
+	if len(raw) == 0 || string(raw) == "null" {
+		return data, fmt.Errorf("execution %s has no data, request it with includeData", e.Id)
+	}
+
+	if raw[0] == '"' {
+		var text string
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return data, err
+		}
+		raw = bytes.TrimSpace([]byte(text))
+	}
+
+	var err error
+	if len(raw) > 0 && raw[0] == '[' {
+		err = DecodeFlatted(raw, &data)
+	} else {
+		err = json.Unmarshal(raw, &data)
+	}
+	if err != nil {
+		return N8nExecutionData{}, fmt.Errorf("error decoding data of execution %s: %v", e.Id, err)
+	}
+
+	return data, nil
+}
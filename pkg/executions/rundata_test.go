@@ -0,0 +1,193 @@
+package executions
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// flattedRunData is the flatted serialization of a Webhook node feeding a Set node
+const flattedRunData = `[
+	{"resultData":"1"},
+	{"runData":"2","lastNodeExecuted":"3"},
+	{"Webhook":"4","Set":"5"},
+	"Set",
+	["6"],
+	["7"],
+	{"startTime":1714557600000,"executionTime":3,"executionStatus":"8","source":"9","data":"10"},
+	{"startTime":1714557600003,"executionTime":2,"executionStatus":"8","source":"11","data":"12"},
+	"success",
+	[],
+	{"main":"13"},
+	["14"],
+	{"main":"15"},
+	["16"],
+	{"previousNode":"17"},
+	["18"],
+	["19"],
+	"Webhook",
+	["20"],
+	{"json":"21","binary":"22"},
+	{"json":"23"},
+	{"body":"24"},
+	{"file":"25"},
+	{"greeting":"26","count":3,"tags":"9"},
+	{"name":"27"},
+	{"mimeType":"28","fileName":"29","fileSize":"30","data":"31"},
+	"hello Ada",
+	"Ada",
+	"text/plain",
+	"hello.txt",
+	"5 B",
+	"aGVsbG8="
+]`
+
+func TestDecodeFlatted(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    interface{}
+		expectError bool
+	}{
+		{
+			name:     "nested references",
+			input:    `[{"a":"1","b":"2","n":1,"t":true,"z":null},"x",["1","3"],{"c":"1"}]`,
+			expected: map[string]interface{}{"a": "x", "b": []interface{}{"x", map[string]interface{}{"c": "x"}}, "n": float64(1), "t": true, "z": nil},
+		},
+		{
+			name:     "shared reference",
+			input:    `[{"a":"1","b":"1"},{"v":"2"},"shared"]`,
+			expected: map[string]interface{}{"a": map[string]interface{}{"v": "shared"}, "b": map[string]interface{}{"v": "shared"}},
+		},
+		{
+			name:     "primitive root",
+			input:    `["only"]`,
+			expected: "only",
+		},
+		{
+			name:        "circular reference",
+			input:       `[{"self":"0"}]`,
+			expectError: true,
+		},
+		{
+			name:        "reference out of range",
+			input:       `[{"a":"5"}]`,
+			expectError: true,
+		},
+		{
+			name:        "not an array",
+			input:       `{"a":"1"}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result interface{}
+			err := DecodeFlatted([]byte(tt.input), &result)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestExecutionData(t *testing.T) {
+	quoted, _ := json.Marshal(flattedRunData)
+
+	tests := []struct {
+		name        string
+		data        string
+		expectError bool
+	}{
+		{
+			name: "flatted array",
+			data: flattedRunData,
+		},
+		{
+			name: "flatted string",
+			data: string(quoted),
+		},
+		{
+			name:        "no data",
+			data:        "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execution := N8nExecution{Id: "1", Data: json.RawMessage(tt.data)}
+
+			data, err := execution.ExecutionData()
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Set", data.ResultData.LastNodeExecuted)
+			assert.Len(t, data.ResultData.RunData, 2)
+
+			webhook := data.ResultData.RunData["Webhook"][0]
+			assert.Equal(t, "success", webhook.ExecutionStatus)
+			assert.Equal(t, time.UnixMilli(1714557600000), webhook.Started())
+			assert.Equal(t, 3*time.Millisecond, webhook.Duration())
+
+			items, err := data.ResultData.RunData.Output("Webhook", 0)
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"name": "Ada"}, items[0].Json["body"])
+			assert.Equal(t, N8nBinaryData{MimeType: "text/plain", FileName: "hello.txt", FileSize: "5 B", Data: "aGVsbG8="}, items[0].Binary["file"])
+
+			set := data.ResultData.RunData["Set"][0]
+			assert.Equal(t, "Webhook", set.Source[0].PreviousNode)
+
+			items, err = data.ResultData.RunData.Output("Set", 0)
+			assert.NoError(t, err)
+			assert.Equal(t, "hello Ada", items[0].Json["greeting"])
+			assert.Equal(t, float64(3), items[0].Json["count"])
+
+			_, err = data.ResultData.RunData.Output("Set", 1)
+			assert.Error(t, err)
+			_, err = data.ResultData.RunData.Output("Missing", 0)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExecutionDataPlainObject(t *testing.T) {
+	execution := N8nExecution{Id: "1", Data: json.RawMessage(`{
+		"resultData": {
+			"runData": {
+				"HTTP Request": [
+					{
+						"startTime": 1714557600000,
+						"executionTime": 120,
+						"data": {"main": [null]},
+						"error": {"name": "NodeApiError", "message": "403 Forbidden", "node": {"name": "HTTP Request", "type": "n8n-nodes-base.httpRequest"}}
+					}
+				]
+			},
+			"error": {"message": "403 Forbidden"}
+		}
+	}`)}
+
+	data, err := execution.ExecutionData()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "403 Forbidden", data.ResultData.Error.Message)
+	run := data.ResultData.RunData["HTTP Request"][0]
+	assert.Equal(t, "NodeApiError", run.Error.Name)
+	assert.Equal(t, "n8n-nodes-base.httpRequest", run.Error.Node.Type)
+
+	items, err := data.ResultData.RunData.Output("HTTP Request", 0)
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+}
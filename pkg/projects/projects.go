@@ -0,0 +1,233 @@
+package projects
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+)
+
+type Projects struct {
+	Client *client.Client
+}
+
+// ProjectRole is the role of a user inside a project
+type ProjectRole string
+
+const (
+	RoleProjectAdmin  ProjectRole = "project:admin"
+	RoleProjectEditor ProjectRole = "project:editor"
+	RoleProjectViewer ProjectRole = "project:viewer"
+	// RoleProjectPersonalOwner is held by the owner of a personal project and can not be assigned
+	RoleProjectPersonalOwner ProjectRole = "project:personalOwner"
+)
+
+type N8nProject struct {
+	Id   string `json:"id,omitempty"`
+	Name string `json:"name"`
+	// Type is either team or personal
+	Type string `json:"type,omitempty"`
+}
+
+// N8nProjectMember relates a user to a project with a role
+type N8nProjectMember struct {
+	UserId string      `json:"userId"`
+	Role   ProjectRole `json:"role"`
+}
+
+// IsAssignable reports whether the role can be given to project members
+func (r ProjectRole) IsAssignable() bool {
+	switch r {
+	case RoleProjectAdmin, RoleProjectEditor, RoleProjectViewer:
+		return true
+	}
+	return false
+}
+
+func NewProjects(client *client.Client) *Projects {
+	return &Projects{Client: client}
+}
+
+// ListProjects retrieves every project
+func (p *Projects) ListProjects() ([]N8nProject, error) {
+	return p.ListProjectsContext(context.Background())
+}
+
+// ListProjectsContext is like ListProjects but binds every page request to ctx
+func (p *Projects) ListProjectsContext(ctx context.Context) ([]N8nProject, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/projects", p.Client.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.Client.GetPaginatedContext(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []N8nProject
+	err = json.Unmarshal(resp, &projects)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// CreateProject creates a new team project
+func (p *Projects) CreateProject(name string) (N8nProject, error) {
+	return p.CreateProjectContext(context.Background(), name)
+}
+
+// CreateProjectContext is like CreateProject but binds the request to ctx
+func (p *Projects) CreateProjectContext(ctx context.Context, name string) (N8nProject, error) {
+	if name == "" {
+		return N8nProject{}, fmt.Errorf("name should not be empty when creating a project")
+	}
+
+	resp, err := p.send(ctx, "POST", "/projects", N8nProject{Name: name})
+
+	if err != nil {
+		return N8nProject{}, err
+	}
+
+	var project N8nProject
+	err = json.Unmarshal(resp, &project)
+
+	if err != nil {
+		return N8nProject{}, err
+	}
+
+	return project, nil
+}
+
+// UpdateProject renames a project
+func (p *Projects) UpdateProject(id, name string) (bool, error) {
+	return p.UpdateProjectContext(context.Background(), id, name)
+}
+
+// UpdateProjectContext is like UpdateProject but binds the request to ctx
+func (p *Projects) UpdateProjectContext(ctx context.Context, id, name string) (bool, error) {
+	if name == "" {
+		return false, fmt.Errorf("name should not be empty when updating a project")
+	}
+
+	_, err := p.send(ctx, "PUT", fmt.Sprintf("/projects/%s", url.PathEscape(id)), N8nProject{Name: name})
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteProject deletes a project by its ID
+func (p *Projects) DeleteProject(id string) (bool, error) {
+	return p.DeleteProjectContext(context.Background(), id)
+}
+
+// DeleteProjectContext is like DeleteProject but binds the request to ctx
+func (p *Projects) DeleteProjectContext(ctx context.Context, id string) (bool, error) {
+	_, err := p.send(ctx, "DELETE", fmt.Sprintf("/projects/%s", url.PathEscape(id)), nil)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// AddProjectMembers adds users to a project with the given roles
+func (p *Projects) AddProjectMembers(projectId string, members []N8nProjectMember) (bool, error) {
+	return p.AddProjectMembersContext(context.Background(), projectId, members)
+}
+
+// AddProjectMembersContext is like AddProjectMembers but binds the request to ctx
+func (p *Projects) AddProjectMembersContext(ctx context.Context, projectId string, members []N8nProjectMember) (bool, error) {
+	if len(members) == 0 {
+		return false, fmt.Errorf("at least one member should be given when adding project members")
+	}
+
+	for _, member := range members {
+		if member.UserId == "" {
+			return false, fmt.Errorf("userId should not be empty when adding project members")
+		}
+		if !member.Role.IsAssignable() {
+			return false, fmt.Errorf("role %q can not be assigned to project members", member.Role)
+		}
+	}
+
+	payload := map[string][]N8nProjectMember{"relations": members}
+	_, err := p.send(ctx, "POST", fmt.Sprintf("/projects/%s/users", url.PathEscape(projectId)), payload)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RemoveProjectMember removes a user from a project
+func (p *Projects) RemoveProjectMember(projectId, userId string) (bool, error) {
+	return p.RemoveProjectMemberContext(context.Background(), projectId, userId)
+}
+
+// RemoveProjectMemberContext is like RemoveProjectMember but binds the request to ctx
+func (p *Projects) RemoveProjectMemberContext(ctx context.Context, projectId, userId string) (bool, error) {
+	_, err := p.send(ctx, "DELETE", fmt.Sprintf("/projects/%s/users/%s", url.PathEscape(projectId), url.PathEscape(userId)), nil)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ChangeProjectMemberRole changes the role of a user inside a project
+func (p *Projects) ChangeProjectMemberRole(projectId, userId string, role ProjectRole) (bool, error) {
+	return p.ChangeProjectMemberRoleContext(context.Background(), projectId, userId, role)
+}
+
+// ChangeProjectMemberRoleContext is like ChangeProjectMemberRole but binds the request to ctx
+func (p *Projects) ChangeProjectMemberRoleContext(ctx context.Context, projectId, userId string, role ProjectRole) (bool, error) {
+	if !role.IsAssignable() {
+		return false, fmt.Errorf("role %q can not be assigned to project members", role)
+	}
+
+	payload := map[string]ProjectRole{"role": role}
+	_, err := p.send(ctx, "PATCH", fmt.Sprintf("/projects/%s/users/%s", url.PathEscape(projectId), url.PathEscape(userId)), payload)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// send performs a request against path with payload encoded as JSON when not nil
+func (p *Projects) send(ctx context.Context, method, path string, payload interface{}) ([]byte, error) {
+	var body io.Reader
+	if payload != nil {
+		jsonPayload, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(jsonPayload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.Client.HostURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return p.Client.DoRequest(req)
+}
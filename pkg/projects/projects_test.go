@@ -0,0 +1,190 @@
+package projects
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProjects(t *testing.T) {
+	c := &client.Client{}
+	projects := NewProjects(c)
+	assert.NotNil(t, projects)
+	assert.Equal(t, c, projects.Client)
+}
+
+func TestListProjects(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/projects", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("cursor") == "" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data":       []map[string]interface{}{{"id": "p1", "name": "Payments", "type": "team"}},
+				"nextCursor": "next",
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data":       []map[string]interface{}{{"id": "p2", "name": "Ada Lovelace <ada@example.com>", "type": "personal"}},
+			"nextCursor": nil,
+		})
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	projects := NewProjects(c)
+
+	result, err := projects.ListProjects()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []N8nProject{
+		{Id: "p1", Name: "Payments", Type: "team"},
+		{Id: "p2", Name: "Ada Lovelace <ada@example.com>", Type: "personal"},
+	}, result)
+}
+
+func TestProjectRequests(t *testing.T) {
+	tests := []struct {
+		name           string
+		call           func(p *Projects) (interface{}, error)
+		expectedMethod string
+		expectedPath   string
+		expectedBody   string
+		status         int
+		response       string
+		expectError    bool
+		expectRequest  bool
+	}{
+		{
+			name: "create project",
+			call: func(p *Projects) (interface{}, error) {
+				return p.CreateProject("Payments")
+			},
+			expectedMethod: "POST",
+			expectedPath:   "/projects",
+			expectedBody:   `{"name":"Payments"}`,
+			status:         http.StatusCreated,
+			response:       `{"id":"p1","name":"Payments","type":"team"}`,
+			expectRequest:  true,
+		},
+		{
+			name: "update project",
+			call: func(p *Projects) (interface{}, error) {
+				return p.UpdateProject("p1", "Billing")
+			},
+			expectedMethod: "PUT",
+			expectedPath:   "/projects/p1",
+			expectedBody:   `{"name":"Billing"}`,
+			status:         http.StatusNoContent,
+			expectRequest:  true,
+		},
+		{
+			name: "delete project",
+			call: func(p *Projects) (interface{}, error) {
+				return p.DeleteProject("p1")
+			},
+			expectedMethod: "DELETE",
+			expectedPath:   "/projects/p1",
+			status:         http.StatusNoContent,
+			expectRequest:  true,
+		},
+		{
+			name: "add project members",
+			call: func(p *Projects) (interface{}, error) {
+				return p.AddProjectMembers("p1", []N8nProjectMember{
+					{UserId: "u1", Role: RoleProjectEditor},
+					{UserId: "u2", Role: RoleProjectViewer},
+				})
+			},
+			expectedMethod: "POST",
+			expectedPath:   "/projects/p1/users",
+			expectedBody:   `{"relations":[{"userId":"u1","role":"project:editor"},{"userId":"u2","role":"project:viewer"}]}`,
+			status:         http.StatusCreated,
+			expectRequest:  true,
+		},
+		{
+			name: "add project member with unassignable role",
+			call: func(p *Projects) (interface{}, error) {
+				return p.AddProjectMembers("p1", []N8nProjectMember{{UserId: "u1", Role: RoleProjectPersonalOwner}})
+			},
+			expectError:   true,
+			expectRequest: false,
+		},
+		{
+			name: "remove project member",
+			call: func(p *Projects) (interface{}, error) {
+				return p.RemoveProjectMember("p1", "u1")
+			},
+			expectedMethod: "DELETE",
+			expectedPath:   "/projects/p1/users/u1",
+			status:         http.StatusNoContent,
+			expectRequest:  true,
+		},
+		{
+			name: "change project member role",
+			call: func(p *Projects) (interface{}, error) {
+				return p.ChangeProjectMemberRole("p1", "u1", RoleProjectAdmin)
+			},
+			expectedMethod: "PATCH",
+			expectedPath:   "/projects/p1/users/u1",
+			expectedBody:   `{"role":"project:admin"}`,
+			status:         http.StatusNoContent,
+			expectRequest:  true,
+		},
+		{
+			name: "change project member role rejected",
+			call: func(p *Projects) (interface{}, error) {
+				return p.ChangeProjectMemberRole("p1", "u1", RoleProjectViewer)
+			},
+			expectedMethod: "PATCH",
+			expectedPath:   "/projects/p1/users/u1",
+			expectedBody:   `{"role":"project:viewer"}`,
+			status:         http.StatusForbidden,
+			response:       `{"message":"Your license does not allow for feat:projectRole:viewer"}`,
+			expectError:    true,
+			expectRequest:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requested = true
+				assert.Equal(t, tt.expectedMethod, r.Method)
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				if tt.expectedBody != "" {
+					assert.JSONEq(t, tt.expectedBody, string(body))
+				} else {
+					assert.Empty(t, body)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			projects := NewProjects(c)
+
+			result, err := tt.call(projects)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				if project, ok := result.(N8nProject); ok {
+					assert.Equal(t, N8nProject{Id: "p1", Name: "Payments", Type: "team"}, project)
+				} else {
+					assert.Equal(t, true, result)
+				}
+			}
+			assert.Equal(t, tt.expectRequest, requested)
+		})
+	}
+}
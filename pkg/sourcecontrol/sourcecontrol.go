@@ -0,0 +1,115 @@
+package sourcecontrol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+)
+
+type SourceControl struct {
+	Client *client.Client
+}
+
+// PullOptions configures a pull from the connected git repository. Force
+// overwrites local changes, Variables overrides the value of pulled variables
+type PullOptions struct {
+	Force     bool
+	Variables map[string]string
+}
+
+// N8nImportResult describes what changed in the instance after a pull
+type N8nImportResult struct {
+	Variables   N8nImportedVariables    `json:"variables"`
+	Credentials []N8nImportedCredential `json:"credentials"`
+	Workflows   []N8nImportedWorkflow   `json:"workflows"`
+	Tags        N8nImportedTags         `json:"tags"`
+}
+
+// N8nImportedVariables lists the keys of added and changed variables
+type N8nImportedVariables struct {
+	Added   []string `json:"added"`
+	Changed []string `json:"changed"`
+}
+
+type N8nImportedCredential struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type N8nImportedWorkflow struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type N8nImportedTags struct {
+	Tags     []N8nImportedTag        `json:"tags"`
+	Mappings []N8nImportedTagMapping `json:"mappings"`
+}
+
+type N8nImportedTag struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// N8nImportedTagMapping links a pulled workflow to one of its tags
+type N8nImportedTagMapping struct {
+	WorkflowId string `json:"workflowId"`
+	TagId      string `json:"tagId"`
+}
+
+func NewSourceControl(client *client.Client) *SourceControl {
+	return &SourceControl{Client: client}
+}
+
+// Pull imports the latest changes of the connected git repository. n8n answers
+// with a conflict, matched by client.ErrConflict, when local changes would be
+// overwritten and Force is not set
+func (s *SourceControl) Pull(opts PullOptions) (N8nImportResult, error) {
+	return s.PullContext(context.Background(), opts)
+}
+
+// PullContext is like Pull but binds the request to ctx
+func (s *SourceControl) PullContext(ctx context.Context, opts PullOptions) (N8nImportResult, error) {
+	body := map[string]interface{}{"force": opts.Force}
+	if len(opts.Variables) > 0 {
+		body["variables"] = opts.Variables
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return N8nImportResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/source-control/pull", s.Client.HostURL), bytes.NewReader(payload))
+	if err != nil {
+		return N8nImportResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.Client.DoRequest(req)
+
+	if err != nil {
+		return N8nImportResult{}, err
+	}
+
+	var result N8nImportResult
+	err = json.Unmarshal(resp, &result)
+
+	if err != nil {
+		return N8nImportResult{}, err
+	}
+
+	return result, nil
+}
+
+// Empty reports whether the pull did not change anything
+func (r N8nImportResult) Empty() bool {
+	return len(r.Variables.Added) == 0 && len(r.Variables.Changed) == 0 &&
+		len(r.Credentials) == 0 && len(r.Workflows) == 0 &&
+		len(r.Tags.Tags) == 0 && len(r.Tags.Mappings) == 0
+}
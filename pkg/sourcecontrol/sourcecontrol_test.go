@@ -0,0 +1,92 @@
+package sourcecontrol
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSourceControl(t *testing.T) {
+	c := &client.Client{}
+	s := NewSourceControl(c)
+	assert.NotNil(t, s)
+	assert.Equal(t, c, s.Client)
+}
+
+func TestPull(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           PullOptions
+		response       string
+		status         int
+		expectedBody   string
+		expectedError  error
+		expectedResult N8nImportResult
+	}{
+		{
+			name: "pull with variable overrides",
+			opts: PullOptions{Force: true, Variables: map[string]string{"ENV": "production"}},
+			response: `{
+				"variables": {"added": ["ENV"], "changed": []},
+				"credentials": [{"id": "c1", "name": "Slack", "type": "slackApi"}],
+				"workflows": [{"id": "w1", "name": "Invoices"}],
+				"tags": {"tags": [{"id": "t1", "name": "billing"}], "mappings": [{"workflowId": "w1", "tagId": "t1"}]}
+			}`,
+			status:       http.StatusOK,
+			expectedBody: `{"force":true,"variables":{"ENV":"production"}}`,
+			expectedResult: N8nImportResult{
+				Variables:   N8nImportedVariables{Added: []string{"ENV"}, Changed: []string{}},
+				Credentials: []N8nImportedCredential{{Id: "c1", Name: "Slack", Type: "slackApi"}},
+				Workflows:   []N8nImportedWorkflow{{Id: "w1", Name: "Invoices"}},
+				Tags: N8nImportedTags{
+					Tags:     []N8nImportedTag{{Id: "t1", Name: "billing"}},
+					Mappings: []N8nImportedTagMapping{{WorkflowId: "w1", TagId: "t1"}},
+				},
+			},
+		},
+		{
+			name:          "conflict without force",
+			response:      `{"message":"local changes"}`,
+			status:        http.StatusConflict,
+			expectedBody:  `{"force":false}`,
+			expectedError: client.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/source-control/pull", r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, tt.expectedBody, string(body))
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			s := NewSourceControl(c)
+
+			result, err := s.Pull(tt.opts)
+
+			if tt.expectedError != nil {
+				assert.True(t, errors.Is(err, tt.expectedError))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+			assert.False(t, result.Empty())
+		})
+	}
+}
+
+func TestImportResultEmpty(t *testing.T) {
+	assert.True(t, N8nImportResult{}.Empty())
+	assert.False(t, N8nImportResult{Workflows: []N8nImportedWorkflow{{Id: "w1"}}}.Empty())
+}
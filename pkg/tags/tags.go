@@ -1,7 +1,10 @@
 package tags
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -26,7 +29,12 @@ func NewTags(client *client.Client) *Tags {
 
 // GetTag retrieves a tag by its ID
 func (u *Tags) GetTag(id string) (N8nTag, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/tags/%s", u.Client.HostURL, id), nil)
+	return u.GetTagContext(context.Background(), id)
+}
+
+// GetTagContext retrieves a tag by its ID using the provided context
+func (u *Tags) GetTagContext(ctx context.Context, id string) (N8nTag, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tags/%s", u.Client.HostURL, id), nil)
 	if err != nil {
 		return N8nTag{}, err
 	}
@@ -48,11 +56,20 @@ func (u *Tags) GetTag(id string) (N8nTag, error) {
 
 // CreateTag creates a new tag
 func (u *Tags) CreateTag(name string) (N8nTag, error) {
-	payload := strings.NewReader(fmt.Sprintf("[{\"name\": \"%s\"}]", name))
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/tags", u.Client.HostURL), payload)
+	return u.CreateTagContext(context.Background(), name)
+}
+
+// CreateTagContext creates a new tag using the provided context
+func (u *Tags) CreateTagContext(ctx context.Context, name string) (N8nTag, error) {
+	payload, err := json.Marshal(map[string]string{"name": name})
+	if err != nil {
+		return N8nTag{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/tags", u.Client.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return N8nTag{}, err
 	}
+	req.Header.Set("Content-Type", "application/json")
 	resp, err := u.Client.DoRequest(req)
 
 	if err != nil {
@@ -70,8 +87,13 @@ func (u *Tags) CreateTag(name string) (N8nTag, error) {
 
 // UpdateTag updates an existing tag
 func (u *Tags) UpdateTag(id, name string) (N8nTag, error) {
+	return u.UpdateTagContext(context.Background(), id, name)
+}
+
+// UpdateTagContext updates an existing tag using the provided context
+func (u *Tags) UpdateTagContext(ctx context.Context, id, name string) (N8nTag, error) {
 	payload := strings.NewReader(fmt.Sprintf("{ \"name\": \"%s\"}", name))
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/tags/%s", u.Client.HostURL, id), payload)
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/tags/%s", u.Client.HostURL, id), payload)
 	if err != nil {
 		return N8nTag{}, err
 	}
@@ -92,7 +114,12 @@ func (u *Tags) UpdateTag(id, name string) (N8nTag, error) {
 
 // DeleteTag deletes a tag by its ID
 func (u *Tags) DeleteTag(id string) (bool, error) {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/tags/%s", u.Client.HostURL, id), nil)
+	return u.DeleteTagContext(context.Background(), id)
+}
+
+// DeleteTagContext deletes a tag by its ID using the provided context
+func (u *Tags) DeleteTagContext(ctx context.Context, id string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tags/%s", u.Client.HostURL, id), nil)
 	if err != nil {
 		return false, err
 	}
@@ -104,3 +131,80 @@ func (u *Tags) DeleteTag(id string) (bool, error) {
 
 	return true, nil
 }
+
+// ListTags retrieves every tag of the instance, following pagination
+func (u *Tags) ListTags() ([]N8nTag, error) {
+	return u.ListTagsContext(context.Background())
+}
+
+// ListTagsContext is like ListTags but binds every page request to ctx
+func (u *Tags) ListTagsContext(ctx context.Context) ([]N8nTag, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tags", u.Client.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := u.Client.GetPaginatedContext(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []N8nTag
+	err = json.Unmarshal(resp, &tags)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// GetTagByName retrieves a tag by its name, the error matches client.ErrNotFound
+// when no tag has that name
+func (u *Tags) GetTagByName(name string) (N8nTag, error) {
+	return u.GetTagByNameContext(context.Background(), name)
+}
+
+// GetTagByNameContext is like GetTagByName but binds every request to ctx
+func (u *Tags) GetTagByNameContext(ctx context.Context, name string) (N8nTag, error) {
+	tags, err := u.ListTagsContext(ctx)
+	if err != nil {
+		return N8nTag{}, err
+	}
+
+	for _, tag := range tags {
+		if tag.Name == name {
+			return tag, nil
+		}
+	}
+
+	return N8nTag{}, fmt.Errorf("tag with name %s not found: %w", name, client.ErrNotFound)
+}
+
+// EnsureTag returns the tag with the given name, creating it when it does not
+// exist. A conflict caused by another process creating the same tag
+// concurrently is resolved by fetching the tag it created
+func (u *Tags) EnsureTag(name string) (N8nTag, error) {
+	return u.EnsureTagContext(context.Background(), name)
+}
+
+// EnsureTagContext is like EnsureTag but binds every request to ctx
+func (u *Tags) EnsureTagContext(ctx context.Context, name string) (N8nTag, error) {
+	tag, err := u.GetTagByNameContext(ctx, name)
+	if err == nil {
+		return tag, nil
+	}
+	if !errors.Is(err, client.ErrNotFound) {
+		return N8nTag{}, err
+	}
+
+	tag, err = u.CreateTagContext(ctx, name)
+	if errors.Is(err, client.ErrConflict) {
+		return u.GetTagByNameContext(ctx, name)
+	}
+	if err != nil {
+		return N8nTag{}, err
+	}
+
+	return tag, nil
+}
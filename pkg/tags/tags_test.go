@@ -2,6 +2,7 @@ package tags
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -72,6 +73,9 @@ func TestGetTag(t *testing.T) {
 
 			if tt.expectError {
 				assert.Error(t, err)
+				if tt.expectStatus == http.StatusNotFound {
+					assert.ErrorIs(t, err, client.ErrNotFound)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedTag, tag)
@@ -90,6 +94,8 @@ func TestCreateTag(t *testing.T) {
 		{
 			name: "successful create tag",
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, `{"name":"New Tag"}`, string(body))
 				w.WriteHeader(http.StatusOK)
 				json.NewEncoder(w).Encode(map[string]interface{}{
 					"id":        "1",
@@ -210,3 +216,117 @@ func TestDeleteTag(t *testing.T) {
 		})
 	}
 }
+
+func TestListTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tags", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"data":[{"id":"1","name":"billing"}],"nextCursor":"page2"}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"id":"2","name":"team:payments"}],"nextCursor":null}`))
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	tags := NewTags(c)
+
+	result, err := tags.ListTags()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []N8nTag{{Id: "1", Name: "billing"}, {Id: "2", Name: "team:payments"}}, result)
+}
+
+func TestGetTagByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data":[{"id":"1","name":"billing"}],"nextCursor":null}`))
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	tags := NewTags(c)
+
+	tag, err := tags.GetTagByName("billing")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", tag.Id)
+
+	_, err = tags.GetTagByName("team:payments")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestEnsureTag(t *testing.T) {
+	tests := []struct {
+		name             string
+		listResponses    []string
+		createStatus     int
+		expectedRequests []string
+		expectedId       string
+		expectError      bool
+	}{
+		{
+			name:             "existing tag",
+			listResponses:    []string{`{"data":[{"id":"1","name":"team:payments"}]}`},
+			expectedRequests: []string{"GET /tags"},
+			expectedId:       "1",
+		},
+		{
+			name:             "missing tag is created",
+			listResponses:    []string{`{"data":[]}`},
+			createStatus:     http.StatusOK,
+			expectedRequests: []string{"GET /tags", "POST /tags"},
+			expectedId:       "2",
+		},
+		{
+			name:             "concurrent creation conflict",
+			listResponses:    []string{`{"data":[]}`, `{"data":[{"id":"3","name":"team:payments"}]}`},
+			createStatus:     http.StatusConflict,
+			expectedRequests: []string{"GET /tags", "POST /tags", "GET /tags"},
+			expectedId:       "3",
+		},
+		{
+			name:             "create failure",
+			listResponses:    []string{`{"data":[]}`},
+			createStatus:     http.StatusInternalServerError,
+			expectedRequests: []string{"GET /tags", "POST /tags"},
+			expectError:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			lists := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				if r.Method == "GET" {
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(tt.listResponses[lists]))
+					lists++
+					return
+				}
+				w.WriteHeader(tt.createStatus)
+				if tt.createStatus == http.StatusOK {
+					w.Write([]byte(`{"id":"2","name":"team:payments"}`))
+					return
+				}
+				w.Write([]byte(`{"message":"Tag already exists"}`))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			tags := NewTags(c)
+
+			tag, err := tags.EnsureTag("team:payments")
+
+			assert.Equal(t, tt.expectedRequests, requests)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedId, tag.Id)
+		})
+	}
+}
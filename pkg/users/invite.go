@@ -0,0 +1,124 @@
+package users
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Invite is a user to invite to the instance
+type Invite struct {
+	Email string `json:"email"`
+	Role  string `json:"role,omitempty"`
+}
+
+// InviteStatus is the outcome of a single invite
+type InviteStatus string
+
+const (
+	// InviteCreated means the user was created, or was still pending and was invited again
+	InviteCreated InviteStatus = "created"
+	// InviteAlreadyExists means an active user already has the email
+	InviteAlreadyExists InviteStatus = "already_exists"
+	// InviteFailed means n8n could not invite the user, see InviteResult.Error
+	InviteFailed InviteStatus = "error"
+)
+
+// InviteResult is the outcome of inviting a single user
+type InviteResult struct {
+	Email  string
+	Role   string
+	Status InviteStatus
+	UserId string
+	// InviteAcceptUrl is the URL the user opens to set up the account
+	InviteAcceptUrl string
+	EmailSent       bool
+	Error           string
+}
+
+// n8nInviteResponse is a single entry of the response of POST /users
+type n8nInviteResponse struct {
+	User struct {
+		Id              string `json:"id"`
+		Email           string `json:"email"`
+		InviteAcceptUrl string `json:"inviteAcceptUrl"`
+		EmailSent       bool   `json:"emailSent"`
+		Role            string `json:"role"`
+	} `json:"user"`
+	Error string `json:"error"`
+}
+
+// InviteUsers invites many users in a single request and returns the outcome of
+// every invite, in the order of invites. n8n leaves active users out of its
+// response, so they are reported as InviteAlreadyExists
+func (u *Users) InviteUsers(invites []Invite) ([]InviteResult, error) {
+	return u.InviteUsersContext(context.Background(), invites)
+}
+
+// InviteUsersContext is like InviteUsers but binds the request to ctx
+func (u *Users) InviteUsersContext(ctx context.Context, invites []Invite) ([]InviteResult, error) {
+	if len(invites) == 0 {
+		return []InviteResult{}, nil
+	}
+
+	for _, invite := range invites {
+		if invite.Email == "" {
+			return nil, fmt.Errorf("email should not be empty when inviting a user")
+		}
+	}
+
+	payload, err := json.Marshal(invites)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/users", u.Client.HostURL), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := u.Client.DoRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []n8nInviteResponse
+	err = json.Unmarshal(resp, &responses)
+
+	if err != nil {
+		return nil, err
+	}
+
+	byEmail := make(map[string]n8nInviteResponse, len(responses))
+	for _, response := range responses {
+		byEmail[strings.ToLower(response.User.Email)] = response
+	}
+
+	results := make([]InviteResult, 0, len(invites))
+	for _, invite := range invites {
+		result := InviteResult{Email: invite.Email, Role: invite.Role, Status: InviteAlreadyExists}
+
+		if response, ok := byEmail[strings.ToLower(invite.Email)]; ok {
+			result.Status = InviteCreated
+			result.UserId = response.User.Id
+			result.InviteAcceptUrl = response.User.InviteAcceptUrl
+			result.EmailSent = response.User.EmailSent
+			if response.User.Role != "" {
+				result.Role = response.User.Role
+			}
+			if response.Error != "" {
+				result.Status = InviteFailed
+				result.Error = response.Error
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,89 @@
+package users
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInviteUsers(t *testing.T) {
+	tests := []struct {
+		name            string
+		invites         []Invite
+		response        string
+		status          int
+		expectedBody    string
+		expectError     bool
+		expectedResults []InviteResult
+	}{
+		{
+			name: "mixed outcomes",
+			invites: []Invite{
+				{Email: "new@example.com", Role: "global:member"},
+				{Email: "active@example.com", Role: "global:member"},
+				{Email: "Broken@example.com", Role: "global:admin"},
+			},
+			response: `[
+				{"user": {"id": "2", "email": "new@example.com", "inviteAcceptUrl": "http://localhost:5678/signup?inviterId=1&inviteeId=2", "emailSent": false, "role": "global:member"}, "error": ""},
+				{"user": {"id": "3", "email": "broken@example.com"}, "error": "Email could not be sent"}
+			]`,
+			status:       http.StatusOK,
+			expectedBody: `[{"email":"new@example.com","role":"global:member"},{"email":"active@example.com","role":"global:member"},{"email":"Broken@example.com","role":"global:admin"}]`,
+			expectedResults: []InviteResult{
+				{Email: "new@example.com", Role: "global:member", Status: InviteCreated, UserId: "2", InviteAcceptUrl: "http://localhost:5678/signup?inviterId=1&inviteeId=2"},
+				{Email: "active@example.com", Role: "global:member", Status: InviteAlreadyExists},
+				{Email: "Broken@example.com", Role: "global:admin", Status: InviteFailed, UserId: "3", Error: "Email could not be sent"},
+			},
+		},
+		{
+			name:         "request rejected",
+			invites:      []Invite{{Email: "new@example.com", Role: "global:owner"}},
+			response:     `{"message":"invalid role"}`,
+			status:       http.StatusBadRequest,
+			expectedBody: `[{"email":"new@example.com","role":"global:owner"}]`,
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/users", r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, tt.expectedBody, string(body))
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.response))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			users := NewUsers(c)
+
+			results, err := users.InviteUsers(tt.invites)
+
+			if tt.expectError {
+				assert.ErrorIs(t, err, client.ErrBadRequest)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResults, results)
+		})
+	}
+}
+
+func TestInviteUsersValidation(t *testing.T) {
+	c, _ := client.New("http://localhost", "test")
+	users := NewUsers(c)
+
+	results, err := users.InviteUsers(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+
+	_, err = users.InviteUsers([]Invite{{Role: "global:member"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,193 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// OwnerRole is the global role of the instance owner
+const OwnerRole = "global:owner"
+
+// DesiredUser is an entry of the access list given to ReconcileUsers
+type DesiredUser struct {
+	Email string `json:"email" yaml:"email"`
+	Role  string `json:"role" yaml:"role"`
+}
+
+// UserActionType is the kind of change planned for a user
+type UserActionType string
+
+const (
+	ActionInvite     UserActionType = "invite"
+	ActionChangeRole UserActionType = "change_role"
+	ActionDelete     UserActionType = "delete"
+)
+
+// UserAction is a single change planned for a user
+type UserAction struct {
+	Type   UserActionType
+	Email  string
+	UserId string
+	// CurrentRole is empty for invites
+	CurrentRole string
+	// Role is the desired role, empty for deletions
+	Role string
+}
+
+// UserPlan lists the changes needed to reach the desired access list
+type UserPlan struct {
+	Actions []UserAction
+	// Unchanged lists the emails of users already matching the access list
+	Unchanged []string
+	// Protected lists the emails of owners left untouched although the access
+	// list would change or remove them
+	Protected []string
+}
+
+// ReconcileOptions configures ReconcileUsers
+type ReconcileOptions struct {
+	// DryRun only computes the plan without applying it
+	DryRun bool
+	// KeepUnlisted skips the deletion of users missing from the access list
+	KeepUnlisted bool
+}
+
+// ReconcileResult describes what ReconcileUsers planned and applied
+type ReconcileResult struct {
+	Plan UserPlan
+	// Invites holds the outcome of every planned invite
+	Invites      []InviteResult
+	RolesChanged []string
+	Deleted      []string
+}
+
+// PlanUsers compares the desired access list with the users of the instance
+// and returns the changes ReconcileUsers would apply
+func (u *Users) PlanUsers(desired []DesiredUser, opts ReconcileOptions) (UserPlan, error) {
+	return u.PlanUsersContext(context.Background(), desired, opts)
+}
+
+// PlanUsersContext is like PlanUsers but binds every request to ctx
+func (u *Users) PlanUsersContext(ctx context.Context, desired []DesiredUser, opts ReconcileOptions) (UserPlan, error) {
+	desiredByEmail := make(map[string]DesiredUser, len(desired))
+	for _, user := range desired {
+		email := strings.ToLower(strings.TrimSpace(user.Email))
+		if email == "" {
+			return UserPlan{}, fmt.Errorf("email should not be empty in the desired users")
+		}
+		if user.Role == "" {
+			return UserPlan{}, fmt.Errorf("role should not be empty for desired user %s", user.Email)
+		}
+		if _, ok := desiredByEmail[email]; ok {
+			return UserPlan{}, fmt.Errorf("desired user %s is listed more than once", user.Email)
+		}
+		desiredByEmail[email] = user
+	}
+
+	current, err := u.ListUsersContext(ctx, ListUsersOptions{IncludeRole: true})
+	if err != nil {
+		return UserPlan{}, err
+	}
+
+	var plan UserPlan
+	currentByEmail := make(map[string]N8nUser, len(current))
+	for _, user := range current {
+		email := strings.ToLower(user.Email)
+		currentByEmail[email] = user
+
+		wanted, listed := desiredByEmail[email]
+		if listed && wanted.Role == OwnerRole && user.Role != OwnerRole {
+			return UserPlan{}, fmt.Errorf("role %s can not be assigned to desired user %s", OwnerRole, wanted.Email)
+		}
+
+		switch {
+		case listed && wanted.Role == user.Role:
+			plan.Unchanged = append(plan.Unchanged, user.Email)
+		case !listed && opts.KeepUnlisted:
+			continue
+		case user.Role == OwnerRole:
+			// the owner is never removed nor demoted
+			plan.Protected = append(plan.Protected, user.Email)
+		case listed:
+			plan.Actions = append(plan.Actions, UserAction{Type: ActionChangeRole, Email: user.Email, UserId: user.Id, CurrentRole: user.Role, Role: wanted.Role})
+		default:
+			plan.Actions = append(plan.Actions, UserAction{Type: ActionDelete, Email: user.Email, UserId: user.Id, CurrentRole: user.Role})
+		}
+	}
+
+	for email, user := range desiredByEmail {
+		if _, ok := currentByEmail[email]; !ok {
+			if user.Role == OwnerRole {
+				return UserPlan{}, fmt.Errorf("role %s can not be assigned to desired user %s", OwnerRole, user.Email)
+			}
+			plan.Actions = append(plan.Actions, UserAction{Type: ActionInvite, Email: user.Email, Role: user.Role})
+		}
+	}
+
+	order := map[UserActionType]int{ActionInvite: 0, ActionChangeRole: 1, ActionDelete: 2}
+	sort.Slice(plan.Actions, func(i, j int) bool {
+		if plan.Actions[i].Type != plan.Actions[j].Type {
+			return order[plan.Actions[i].Type] < order[plan.Actions[j].Type]
+		}
+		return plan.Actions[i].Email < plan.Actions[j].Email
+	})
+	sort.Strings(plan.Unchanged)
+	sort.Strings(plan.Protected)
+
+	return plan, nil
+}
+
+// ReconcileUsers makes the users of the instance match the desired access list:
+// missing users are invited, roles are changed and users absent from the list
+// are deleted. The instance owner is never changed. The result lists what was
+// done, including when an error stops the reconciliation halfway
+func (u *Users) ReconcileUsers(desired []DesiredUser, opts ReconcileOptions) (ReconcileResult, error) {
+	return u.ReconcileUsersContext(context.Background(), desired, opts)
+}
+
+// ReconcileUsersContext is like ReconcileUsers but binds every request to ctx
+func (u *Users) ReconcileUsersContext(ctx context.Context, desired []DesiredUser, opts ReconcileOptions) (ReconcileResult, error) {
+	var result ReconcileResult
+
+	plan, err := u.PlanUsersContext(ctx, desired, opts)
+	if err != nil {
+		return result, err
+	}
+	result.Plan = plan
+
+	if opts.DryRun {
+		return result, nil
+	}
+
+	var invites []Invite
+	for _, action := range plan.Actions {
+		if action.Type == ActionInvite {
+			invites = append(invites, Invite{Email: action.Email, Role: action.Role})
+		}
+	}
+	if len(invites) > 0 {
+		result.Invites, err = u.InviteUsersContext(ctx, invites)
+		if err != nil {
+			return result, fmt.Errorf("error inviting users: %w", err)
+		}
+	}
+
+	for _, action := range plan.Actions {
+		switch action.Type {
+		case ActionChangeRole:
+			if _, err := u.UpdateUserContext(ctx, action.UserId, action.Role); err != nil {
+				return result, fmt.Errorf("error changing role of user %s: %w", action.Email, err)
+			}
+			result.RolesChanged = append(result.RolesChanged, action.Email)
+		case ActionDelete:
+			if _, err := u.DeleteUserContext(ctx, action.UserId); err != nil {
+				return result, fmt.Errorf("error deleting user %s: %w", action.Email, err)
+			}
+			result.Deleted = append(result.Deleted, action.Email)
+		}
+	}
+
+	return result, nil
+}
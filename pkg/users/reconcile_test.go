@@ -0,0 +1,169 @@
+package users
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRosterServer serves the given users and records every other request
+func newRosterServer(t *testing.T, current []N8nUser, requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && r.URL.Path == "/users" {
+			assert.Equal(t, "true", r.URL.Query().Get("includeRole"))
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": current, "nextCursor": nil})
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		*requests = append(*requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.WriteHeader(http.StatusOK)
+		switch {
+		case r.Method == "POST":
+			w.Write([]byte(`[{"user":{"id":"9","email":"new@example.com","inviteAcceptUrl":"http://localhost/signup"},"error":""}]`))
+		case r.Method == "GET":
+			w.Write([]byte(`{"id":"2","email":"dev@example.com","role":"global:admin"}`))
+		}
+	}))
+}
+
+var rosterUsers = []N8nUser{
+	{Id: "1", Email: "owner@example.com", Role: "global:owner"},
+	{Id: "2", Email: "dev@example.com", Role: "global:member"},
+	{Id: "3", Email: "Ops@example.com", Role: "global:member"},
+	{Id: "4", Email: "leaver@example.com", Role: "global:member", IsPending: true},
+}
+
+var roster = []DesiredUser{
+	{Email: "dev@example.com", Role: "global:admin"},
+	{Email: "ops@example.com", Role: "global:member"},
+	{Email: "new@example.com", Role: "global:member"},
+}
+
+func TestPlanUsers(t *testing.T) {
+	tests := []struct {
+		name              string
+		desired           []DesiredUser
+		opts              ReconcileOptions
+		expectError       bool
+		expectedActions   []UserAction
+		expectedProtected []string
+	}{
+		{
+			name:    "invites, role changes and deletions",
+			desired: roster,
+			expectedActions: []UserAction{
+				{Type: ActionInvite, Email: "new@example.com", Role: "global:member"},
+				{Type: ActionChangeRole, Email: "dev@example.com", UserId: "2", CurrentRole: "global:member", Role: "global:admin"},
+				{Type: ActionDelete, Email: "leaver@example.com", UserId: "4", CurrentRole: "global:member"},
+			},
+			expectedProtected: []string{"owner@example.com"},
+		},
+		{
+			name:    "keep unlisted users",
+			desired: roster,
+			opts:    ReconcileOptions{KeepUnlisted: true},
+			expectedActions: []UserAction{
+				{Type: ActionInvite, Email: "new@example.com", Role: "global:member"},
+				{Type: ActionChangeRole, Email: "dev@example.com", UserId: "2", CurrentRole: "global:member", Role: "global:admin"},
+			},
+		},
+		{
+			name:              "owner is never demoted",
+			desired:           []DesiredUser{{Email: "owner@example.com", Role: "global:member"}},
+			opts:              ReconcileOptions{KeepUnlisted: true},
+			expectedProtected: []string{"owner@example.com"},
+		},
+		{
+			name:    "owner listed with the owner role",
+			desired: append([]DesiredUser{{Email: "Owner@example.com", Role: OwnerRole}}, roster...),
+			expectedActions: []UserAction{
+				{Type: ActionInvite, Email: "new@example.com", Role: "global:member"},
+				{Type: ActionChangeRole, Email: "dev@example.com", UserId: "2", CurrentRole: "global:member", Role: "global:admin"},
+				{Type: ActionDelete, Email: "leaver@example.com", UserId: "4", CurrentRole: "global:member"},
+			},
+		},
+		{
+			name:        "owner role can not be assigned to a new user",
+			desired:     []DesiredUser{{Email: "new@example.com", Role: OwnerRole}},
+			expectError: true,
+		},
+		{
+			name:        "owner role can not be assigned to an existing user",
+			desired:     []DesiredUser{{Email: "dev@example.com", Role: OwnerRole}},
+			expectError: true,
+		},
+		{
+			name:        "duplicate email",
+			desired:     []DesiredUser{{Email: "dev@example.com", Role: "global:member"}, {Email: "DEV@example.com", Role: "global:admin"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			server := newRosterServer(t, rosterUsers, &requests)
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			users := NewUsers(c)
+
+			plan, err := users.PlanUsers(tt.desired, tt.opts)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedActions, plan.Actions)
+			assert.Equal(t, tt.expectedProtected, plan.Protected)
+			assert.Empty(t, requests)
+		})
+	}
+}
+
+func TestReconcileUsers(t *testing.T) {
+	var requests []string
+	server := newRosterServer(t, rosterUsers, &requests)
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	users := NewUsers(c)
+
+	result, err := users.ReconcileUsers(roster, ReconcileOptions{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`POST /users [{"email":"new@example.com","role":"global:member"}]`,
+		`PATCH /users/2/role { "newRoleName": "global:admin"}`,
+		"GET /users/2 ",
+		"DELETE /users/4 ",
+	}, requests)
+	assert.Equal(t, InviteCreated, result.Invites[0].Status)
+	assert.Equal(t, []string{"dev@example.com"}, result.RolesChanged)
+	assert.Equal(t, []string{"leaver@example.com"}, result.Deleted)
+	assert.Equal(t, []string{"Ops@example.com"}, result.Plan.Unchanged)
+}
+
+func TestReconcileUsersDryRun(t *testing.T) {
+	var requests []string
+	server := newRosterServer(t, rosterUsers, &requests)
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	users := NewUsers(c)
+
+	result, err := users.ReconcileUsers(roster, ReconcileOptions{DryRun: true})
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Plan.Actions, 3)
+	assert.Empty(t, result.Deleted)
+	assert.Empty(t, requests)
+}
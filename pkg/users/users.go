@@ -1,9 +1,12 @@
 package users
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/kevop-s/n8n-client-go/pkg/client"
@@ -19,7 +22,20 @@ type N8nUser struct {
 	FirstName string `json:"firstName"`
 	LastName  string `json:"lastName"`
 	IsPending bool   `json:"isPending"`
+	// Role is only returned when it is requested, see ListUsersOptions.IncludeRole
 	Role      string `json:"role"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+// ListUsersOptions filters the users returned by ListUsers
+type ListUsersOptions struct {
+	// IncludeRole returns the global role of every user
+	IncludeRole bool
+	// ProjectId only returns the members of the given project
+	ProjectId string
+	// Limit sets the page size used while fetching every page
+	Limit int
 }
 
 func NewUsers(client *client.Client) *Users {
@@ -27,7 +43,12 @@ func NewUsers(client *client.Client) *Users {
 }
 
 func (u *Users) GetUser(id string) (N8nUser, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/%s", u.Client.HostURL, id), nil)
+	return u.GetUserContext(context.Background(), id)
+}
+
+// GetUserContext retrieves a user by its ID or email using the provided context
+func (u *Users) GetUserContext(ctx context.Context, id string) (N8nUser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/users/%s", u.Client.HostURL, id), nil)
 	if err != nil {
 		return N8nUser{}, err
 	}
@@ -47,9 +68,58 @@ func (u *Users) GetUser(id string) (N8nUser, error) {
 	return user, nil
 }
 
+// ListUsers retrieves every user of the instance matching the options
+func (u *Users) ListUsers(opts ListUsersOptions) ([]N8nUser, error) {
+	return u.ListUsersContext(context.Background(), opts)
+}
+
+// ListUsersContext is like ListUsers but binds every page request to ctx
+func (u *Users) ListUsersContext(ctx context.Context, opts ListUsersOptions) ([]N8nUser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/users?%s", u.Client.HostURL, opts.query().Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := u.Client.GetPaginatedContext(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var users []N8nUser
+	err = json.Unmarshal(resp, &users)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// query builds the query parameters of the list users request
+func (o ListUsersOptions) query() url.Values {
+	q := url.Values{}
+	if o.IncludeRole {
+		q.Set("includeRole", "true")
+	}
+	if o.ProjectId != "" {
+		q.Set("projectId", o.ProjectId)
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return q
+}
+
+// CreateUser invites a single user, see InviteUsers to invite many users and
+// get the outcome of every invite
 func (u *Users) CreateUser(email, role string) (N8nUser, error) {
+	return u.CreateUserContext(context.Background(), email, role)
+}
+
+// CreateUserContext invites a user with the given role using the provided context
+func (u *Users) CreateUserContext(ctx context.Context, email, role string) (N8nUser, error) {
 	payload := strings.NewReader(fmt.Sprintf("[{\"email\": \"%s\", \"role\": \"%s\"}]", email, role))
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/users", u.Client.HostURL), payload)
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/users", u.Client.HostURL), payload)
 	if err != nil {
 		return N8nUser{}, err
 	}
@@ -59,12 +129,17 @@ func (u *Users) CreateUser(email, role string) (N8nUser, error) {
 		return N8nUser{}, err
 	}
 
-	return u.GetUser(email)
+	return u.GetUserContext(ctx, email)
 }
 
 func (u *Users) UpdateUser(email, role string) (N8nUser, error) {
+	return u.UpdateUserContext(context.Background(), email, role)
+}
+
+// UpdateUserContext changes the role of a user using the provided context
+func (u *Users) UpdateUserContext(ctx context.Context, email, role string) (N8nUser, error) {
 	payload := strings.NewReader(fmt.Sprintf("{ \"newRoleName\": \"%s\"}", role))
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/users/%s/role", u.Client.HostURL, email), payload)
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/users/%s/role", u.Client.HostURL, email), payload)
 	if err != nil {
 		return N8nUser{}, err
 	}
@@ -74,11 +149,16 @@ func (u *Users) UpdateUser(email, role string) (N8nUser, error) {
 		return N8nUser{}, err
 	}
 
-	return u.GetUser(email)
+	return u.GetUserContext(ctx, email)
 }
 
 func (u *Users) DeleteUser(email string) (bool, error) {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/users/%s", u.Client.HostURL, email), nil)
+	return u.DeleteUserContext(context.Background(), email)
+}
+
+// DeleteUserContext deletes a user by its ID or email using the provided context
+func (u *Users) DeleteUserContext(ctx context.Context, email string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/users/%s", u.Client.HostURL, email), nil)
 	if err != nil {
 		return false, err
 	}
@@ -81,6 +81,54 @@ func TestGetUser(t *testing.T) {
 	}
 }
 
+func TestListUsers(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          ListUsersOptions
+		expectedQuery string
+	}{
+		{
+			name:          "no options",
+			expectedQuery: "",
+		},
+		{
+			name:          "role and project filter",
+			opts:          ListUsersOptions{IncludeRole: true, ProjectId: "p1", Limit: 50},
+			expectedQuery: "includeRole=true&limit=50&projectId=p1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pages := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/users", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				if pages == 0 {
+					assert.Equal(t, tt.expectedQuery, r.URL.RawQuery)
+					pages++
+					w.Write([]byte(`{"data":[{"id":"1","email":"owner@example.com","role":"global:owner","isPending":false,"createdAt":"2024-01-01T00:00:00.000Z","updatedAt":"2024-02-01T00:00:00.000Z"}],"nextCursor":"page2"}`))
+					return
+				}
+				assert.Equal(t, "page2", r.URL.Query().Get("cursor"))
+				w.Write([]byte(`{"data":[{"id":"2","email":"new@example.com","role":"global:member","isPending":true}],"nextCursor":null}`))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			users := NewUsers(c)
+
+			result, err := users.ListUsers(tt.opts)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []N8nUser{
+				{Id: "1", Email: "owner@example.com", Role: "global:owner", CreatedAt: "2024-01-01T00:00:00.000Z", UpdatedAt: "2024-02-01T00:00:00.000Z"},
+				{Id: "2", Email: "new@example.com", Role: "global:member", IsPending: true},
+			}, result)
+		})
+	}
+}
+
 func TestCreateUser(t *testing.T) {
 	tests := []struct {
 		name        string
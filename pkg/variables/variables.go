@@ -0,0 +1,208 @@
+package variables
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+)
+
+type Variables struct {
+	Client *client.Client
+}
+
+type N8nVariable struct {
+	Id    string `json:"id,omitempty"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Type  string `json:"type,omitempty"`
+}
+
+// SyncResult lists the keys changed by SyncVariables
+type SyncResult struct {
+	Created   []string
+	Updated   []string
+	Deleted   []string
+	Unchanged []string
+}
+
+func NewVariables(client *client.Client) *Variables {
+	return &Variables{Client: client}
+}
+
+// ListVariables retrieves every variable
+func (v *Variables) ListVariables() ([]N8nVariable, error) {
+	return v.ListVariablesContext(context.Background())
+}
+
+// ListVariablesContext is like ListVariables but binds every page request to ctx
+func (v *Variables) ListVariablesContext(ctx context.Context) ([]N8nVariable, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/variables", v.Client.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := v.Client.GetPaginatedContext(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var variables []N8nVariable
+	err = json.Unmarshal(resp, &variables)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
+
+// CreateVariable creates a new variable
+func (v *Variables) CreateVariable(key, value string) (bool, error) {
+	return v.CreateVariableContext(context.Background(), key, value)
+}
+
+// CreateVariableContext is like CreateVariable but binds the request to ctx
+func (v *Variables) CreateVariableContext(ctx context.Context, key, value string) (bool, error) {
+	if key == "" {
+		return false, fmt.Errorf("key should not be empty when creating a variable")
+	}
+
+	_, err := v.send(ctx, "POST", "/variables", N8nVariable{Key: key, Value: value})
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UpdateVariable updates the key and value of an existing variable
+func (v *Variables) UpdateVariable(id, key, value string) (bool, error) {
+	return v.UpdateVariableContext(context.Background(), id, key, value)
+}
+
+// UpdateVariableContext is like UpdateVariable but binds the request to ctx
+func (v *Variables) UpdateVariableContext(ctx context.Context, id, key, value string) (bool, error) {
+	if key == "" {
+		return false, fmt.Errorf("key should not be empty when updating a variable")
+	}
+
+	_, err := v.send(ctx, "PUT", fmt.Sprintf("/variables/%s", url.PathEscape(id)), N8nVariable{Key: key, Value: value})
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteVariable deletes a variable by its ID
+func (v *Variables) DeleteVariable(id string) (bool, error) {
+	return v.DeleteVariableContext(context.Background(), id)
+}
+
+// DeleteVariableContext is like DeleteVariable but binds the request to ctx
+func (v *Variables) DeleteVariableContext(ctx context.Context, id string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/variables/%s", v.Client.HostURL, url.PathEscape(id)), nil)
+	if err != nil {
+		return false, err
+	}
+	_, err = v.Client.DoRequest(req)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SyncVariables makes the instance variables match desired, a map of keys to
+// values. Missing keys are created, changed values updated and keys absent from
+// desired deleted. The result lists what was done, including when an error
+// stops the sync halfway
+func (v *Variables) SyncVariables(desired map[string]string) (SyncResult, error) {
+	return v.SyncVariablesContext(context.Background(), desired)
+}
+
+// SyncVariablesContext is like SyncVariables but binds every request to ctx
+func (v *Variables) SyncVariablesContext(ctx context.Context, desired map[string]string) (SyncResult, error) {
+	var result SyncResult
+
+	current, err := v.ListVariablesContext(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	currentByKey := make(map[string]N8nVariable, len(current))
+	for _, variable := range current {
+		currentByKey[variable.Key] = variable
+	}
+
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := desired[key]
+		variable, exists := currentByKey[key]
+
+		switch {
+		case !exists:
+			if _, err := v.CreateVariableContext(ctx, key, value); err != nil {
+				return result, fmt.Errorf("error creating variable %s: %w", key, err)
+			}
+			result.Created = append(result.Created, key)
+		case variable.Value != value:
+			if _, err := v.UpdateVariableContext(ctx, variable.Id, key, value); err != nil {
+				return result, fmt.Errorf("error updating variable %s: %w", key, err)
+			}
+			result.Updated = append(result.Updated, key)
+		default:
+			result.Unchanged = append(result.Unchanged, key)
+		}
+	}
+
+	sort.Slice(current, func(i, j int) bool { return current[i].Key < current[j].Key })
+	for _, variable := range current {
+		if _, wanted := desired[variable.Key]; wanted {
+			continue
+		}
+		if _, err := v.DeleteVariableContext(ctx, variable.Id); err != nil {
+			return result, fmt.Errorf("error deleting variable %s: %w", variable.Key, err)
+		}
+		result.Deleted = append(result.Deleted, variable.Key)
+	}
+
+	return result, nil
+}
+
+// send performs a request against path with payload encoded as JSON when not nil
+func (v *Variables) send(ctx context.Context, method, path string, payload interface{}) ([]byte, error) {
+	var body io.Reader
+	if payload != nil {
+		jsonPayload, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(jsonPayload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, v.Client.HostURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return v.Client.DoRequest(req)
+}
@@ -0,0 +1,193 @@
+package variables
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVariables(t *testing.T) {
+	c := &client.Client{}
+	variables := NewVariables(c)
+	assert.NotNil(t, variables)
+	assert.Equal(t, c, variables.Client)
+}
+
+func TestListVariables(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/variables", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{"id": "1", "key": "BASE_URL", "value": "https://api.example.com", "type": "string"},
+			},
+			"nextCursor": nil,
+		})
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	variables := NewVariables(c)
+
+	result, err := variables.ListVariables()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []N8nVariable{{Id: "1", Key: "BASE_URL", Value: "https://api.example.com", Type: "string"}}, result)
+}
+
+func TestVariableRequests(t *testing.T) {
+	tests := []struct {
+		name           string
+		call           func(v *Variables) (bool, error)
+		expectedMethod string
+		expectedPath   string
+		expectedBody   string
+		status         int
+		expectError    bool
+	}{
+		{
+			name: "create variable",
+			call: func(v *Variables) (bool, error) {
+				return v.CreateVariable("BASE_URL", "https://api.example.com")
+			},
+			expectedMethod: "POST",
+			expectedPath:   "/variables",
+			expectedBody:   `{"key":"BASE_URL","value":"https://api.example.com"}`,
+			status:         http.StatusCreated,
+		},
+		{
+			name: "update variable",
+			call: func(v *Variables) (bool, error) {
+				return v.UpdateVariable("1", "BASE_URL", "https://staging.example.com")
+			},
+			expectedMethod: "PUT",
+			expectedPath:   "/variables/1",
+			expectedBody:   `{"key":"BASE_URL","value":"https://staging.example.com"}`,
+			status:         http.StatusNoContent,
+		},
+		{
+			name: "delete variable",
+			call: func(v *Variables) (bool, error) {
+				return v.DeleteVariable("1")
+			},
+			expectedMethod: "DELETE",
+			expectedPath:   "/variables/1",
+			status:         http.StatusNoContent,
+		},
+		{
+			name: "variables not licensed",
+			call: func(v *Variables) (bool, error) {
+				return v.CreateVariable("BASE_URL", "https://api.example.com")
+			},
+			expectedMethod: "POST",
+			expectedPath:   "/variables",
+			expectedBody:   `{"key":"BASE_URL","value":"https://api.example.com"}`,
+			status:         http.StatusForbidden,
+			expectError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.expectedMethod, r.Method)
+				assert.Equal(t, tt.expectedPath, r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				if tt.expectedBody != "" {
+					assert.JSONEq(t, tt.expectedBody, string(body))
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			variables := NewVariables(c)
+
+			success, err := tt.call(variables)
+
+			if tt.expectError {
+				assert.ErrorIs(t, err, client.ErrForbidden)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, success)
+			}
+		})
+	}
+}
+
+func TestSyncVariables(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		if r.Method == "GET" {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"id": "1", "key": "BASE_URL", "value": "https://old.example.com"},
+					{"id": "2", "key": "FEATURE_X", "value": "true"},
+					{"id": "3", "key": "LEGACY", "value": "1"},
+				},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	variables := NewVariables(c)
+
+	result, err := variables.SyncVariables(map[string]string{
+		"BASE_URL":  "https://api.example.com",
+		"FEATURE_X": "true",
+		"FEATURE_Y": "false",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, SyncResult{
+		Created:   []string{"FEATURE_Y"},
+		Updated:   []string{"BASE_URL"},
+		Deleted:   []string{"LEGACY"},
+		Unchanged: []string{"FEATURE_X"},
+	}, result)
+	assert.Equal(t, []string{
+		"GET /variables ",
+		`PUT /variables/1 {"key":"BASE_URL","value":"https://api.example.com"}`,
+		`POST /variables {"key":"FEATURE_Y","value":"false"}`,
+		"DELETE /variables/3 ",
+	}, requests)
+}
+
+func TestSyncVariablesStopsOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data":[]}`))
+		case "POST":
+			var variable N8nVariable
+			json.NewDecoder(r.Body).Decode(&variable)
+			if variable.Key == "B" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"message":"invalid key"}`))
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	variables := NewVariables(c)
+
+	result, err := variables.SyncVariables(map[string]string{"A": "1", "B": "2", "C": "3"})
+
+	assert.ErrorIs(t, err, client.ErrBadRequest)
+	assert.Equal(t, []string{"A"}, result.Created)
+}
@@ -0,0 +1,104 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+)
+
+// Sentinel errors matched by ActivationError through errors.Is
+var (
+	ErrNoTriggerNode      = errors.New("workflow has no trigger node")
+	ErrMissingCredentials = errors.New("workflow node is missing credentials")
+)
+
+// ActivationError is returned by ActivateWorkflow when n8n refuses to activate a workflow.
+// It matches ErrNoTriggerNode or ErrMissingCredentials depending on the reason, and
+// unwraps to the underlying *client.APIError
+type ActivationError struct {
+	WorkflowId string
+	Message    string
+	reason     error
+	apiErr     *client.APIError
+}
+
+func (e *ActivationError) Error() string {
+	return fmt.Sprintf("workflow %s could not be activated: %s", e.WorkflowId, e.Message)
+}
+
+// Is matches the sentinel error describing why the activation failed
+func (e *ActivationError) Is(target error) bool {
+	return e.reason != nil && target == e.reason
+}
+
+func (e *ActivationError) Unwrap() error {
+	if e.apiErr == nil {
+		return nil
+	}
+	return e.apiErr
+}
+
+// ActivateWorkflow activates a workflow so its triggers start running
+func (w *Workflows) ActivateWorkflow(id string) (N8nWorkflow, error) {
+	return w.ActivateWorkflowContext(context.Background(), id)
+}
+
+// ActivateWorkflowContext is like ActivateWorkflow but binds the request to ctx
+func (w *Workflows) ActivateWorkflowContext(ctx context.Context, id string) (N8nWorkflow, error) {
+	workflow, err := w.setWorkflowActive(ctx, id, "activate")
+
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		return N8nWorkflow{}, &ActivationError{
+			WorkflowId: id,
+			Message:    apiErr.Message,
+			reason:     activationFailureReason(apiErr.Message),
+			apiErr:     apiErr,
+		}
+	}
+
+	return workflow, err
+}
+
+// DeactivateWorkflow deactivates a workflow so its triggers stop running
+func (w *Workflows) DeactivateWorkflow(id string) (N8nWorkflow, error) {
+	return w.DeactivateWorkflowContext(context.Background(), id)
+}
+
+// DeactivateWorkflowContext is like DeactivateWorkflow but binds the request to ctx
+func (w *Workflows) DeactivateWorkflowContext(ctx context.Context, id string) (N8nWorkflow, error) {
+	return w.setWorkflowActive(ctx, id, "deactivate")
+}
+
+// setWorkflowActive calls the activate or deactivate endpoint of a workflow
+func (w *Workflows) setWorkflowActive(ctx context.Context, id string, action string) (N8nWorkflow, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/workflows/%s/%s", w.Client.HostURL, id, action), nil)
+	if err != nil {
+		return N8nWorkflow{}, err
+	}
+	resp, err := w.Client.DoRequest(req)
+
+	if err != nil {
+		return N8nWorkflow{}, err
+	}
+
+	return w.decodeWorkflow(resp)
+}
+
+// activationFailureReason maps the message of a failed activation to a sentinel error
+func activationFailureReason(message string) error {
+	message = strings.ToLower(message)
+
+	switch {
+	case strings.Contains(message, "trigger") || strings.Contains(message, "node to start"):
+		return ErrNoTriggerNode
+	case strings.Contains(message, "credential"):
+		return ErrMissingCredentials
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package workflows
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivateWorkflow(t *testing.T) {
+	tests := []struct {
+		name          string
+		server        *httptest.Server
+		expectError   bool
+		expectErrorIs []error
+	}{
+		{
+			name: "successful activation",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/workflows/1/activate", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":          "1",
+					"name":        "Test Workflow",
+					"active":      true,
+					"nodes":       []interface{}{},
+					"connections": map[string]interface{}{},
+				})
+			})),
+			expectError: false,
+		},
+		{
+			name: "no trigger node",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"message":"Workflow has no node to start the workflow - at least one trigger, poller or webhook node is required"}`))
+			})),
+			expectError:   true,
+			expectErrorIs: []error{ErrNoTriggerNode, client.ErrBadRequest},
+		},
+		{
+			name: "missing credentials",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"message":"Node \"Slack\" does not have any credentials set"}`))
+			})),
+			expectError:   true,
+			expectErrorIs: []error{ErrMissingCredentials, client.ErrBadRequest},
+		},
+		{
+			name: "workflow not found",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"Not Found"}`))
+			})),
+			expectError:   true,
+			expectErrorIs: []error{client.ErrNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer tt.server.Close()
+
+			c, _ := client.New(tt.server.URL, "test")
+			w := NewWorkflows(c)
+
+			workflow, err := w.ActivateWorkflow("1")
+
+			if tt.expectError {
+				assert.Error(t, err)
+				for _, target := range tt.expectErrorIs {
+					assert.ErrorIs(t, err, target)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, workflow.Active)
+			}
+		})
+	}
+}
+
+func TestActivationErrorReasons(t *testing.T) {
+	var activationErr *ActivationError
+	err := error(&ActivationError{WorkflowId: "1", Message: "something else"})
+
+	assert.True(t, errors.As(err, &activationErr))
+	assert.NotErrorIs(t, err, ErrNoTriggerNode)
+	assert.NotErrorIs(t, err, ErrMissingCredentials)
+}
+
+func TestDeactivateWorkflow(t *testing.T) {
+	tests := []struct {
+		name        string
+		server      *httptest.Server
+		expectError bool
+	}{
+		{
+			name: "successful deactivation",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/workflows/1/deactivate", r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":          "1",
+					"name":        "Test Workflow",
+					"active":      false,
+					"nodes":       []interface{}{},
+					"connections": map[string]interface{}{},
+				})
+			})),
+			expectError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer tt.server.Close()
+
+			c, _ := client.New(tt.server.URL, "test")
+			w := NewWorkflows(c)
+
+			workflow, err := w.DeactivateWorkflow("1")
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "1", workflow.Id)
+				assert.False(t, workflow.Active)
+			}
+		})
+	}
+}
@@ -1,9 +1,10 @@
 package workflows
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/kevop-s/n8n-client-go/pkg/utils"
+	"github.com/kevop-s/n8n-client-go/pkg/client"
 )
 
 type N8nConnection struct {
@@ -20,7 +21,12 @@ type N8nConnectionOutput struct {
 }
 
 func (w *Workflows) GetConnectionBySourceNodeName(workflowId string, connectionSourceNodeName string) (N8nConnection, error) {
-	workflow, err := w.GetWorkflow(workflowId)
+	return w.GetConnectionBySourceNodeNameContext(context.Background(), workflowId, connectionSourceNodeName)
+}
+
+// GetConnectionBySourceNodeNameContext is like GetConnectionBySourceNodeName but binds the request to ctx
+func (w *Workflows) GetConnectionBySourceNodeNameContext(ctx context.Context, workflowId string, connectionSourceNodeName string) (N8nConnection, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return N8nConnection{}, err
@@ -32,11 +38,16 @@ func (w *Workflows) GetConnectionBySourceNodeName(workflowId string, connectionS
 		}
 	}
 
-	return N8nConnection{}, nil
+	return N8nConnection{}, fmt.Errorf("connection from node %s not found in workflow %s: %w", connectionSourceNodeName, workflowId, client.ErrNotFound)
 }
 
 func (w *Workflows) GetConnections(workflowId string) ([]N8nConnection, error) {
-	workflow, err := w.GetWorkflow(workflowId)
+	return w.GetConnectionsContext(context.Background(), workflowId)
+}
+
+// GetConnectionsContext is like GetConnections but binds the request to ctx
+func (w *Workflows) GetConnectionsContext(ctx context.Context, workflowId string) ([]N8nConnection, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return nil, err
@@ -46,7 +57,12 @@ func (w *Workflows) GetConnections(workflowId string) ([]N8nConnection, error) {
 }
 
 func (w *Workflows) AddConnection(workflowId string, connection N8nConnection) (N8nConnection, error) {
-	workflow, err := w.GetWorkflow(workflowId)
+	return w.AddConnectionContext(context.Background(), workflowId, connection)
+}
+
+// AddConnectionContext is like AddConnection but binds every request to ctx
+func (w *Workflows) AddConnectionContext(ctx context.Context, workflowId string, connection N8nConnection) (N8nConnection, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return N8nConnection{}, err
@@ -60,7 +76,7 @@ func (w *Workflows) AddConnection(workflowId string, connection N8nConnection) (
 
 	workflow.Connections = append(workflow.Connections, connection)
 
-	_, err = w.UpdateWorkflow(workflowId, workflow)
+	_, err = w.UpdateWorkflowContext(ctx, workflowId, workflow)
 
 	if err != nil {
 		return N8nConnection{}, err
@@ -70,7 +86,12 @@ func (w *Workflows) AddConnection(workflowId string, connection N8nConnection) (
 }
 
 func (w *Workflows) RemoveConnection(workflowId string, connectionSourceNodeName string) (bool, error) {
-	workflow, err := w.GetWorkflow(workflowId)
+	return w.RemoveConnectionContext(context.Background(), workflowId, connectionSourceNodeName)
+}
+
+// RemoveConnectionContext is like RemoveConnection but binds every request to ctx
+func (w *Workflows) RemoveConnectionContext(ctx context.Context, workflowId string, connectionSourceNodeName string) (bool, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return false, err
@@ -86,7 +107,7 @@ func (w *Workflows) RemoveConnection(workflowId string, connectionSourceNodeName
 
 	workflow.Connections = finalConnections
 
-	_, err = w.UpdateWorkflow(workflowId, workflow)
+	_, err = w.UpdateWorkflowContext(ctx, workflowId, workflow)
 
 	if err != nil {
 		return false, err
@@ -96,7 +117,12 @@ func (w *Workflows) RemoveConnection(workflowId string, connectionSourceNodeName
 }
 
 func (w *Workflows) UpdateConnection(workflowId string, connectionSourceNodeName string, connection N8nConnection) (N8nConnection, error) {
-	workflow, err := w.GetWorkflow(workflowId)
+	return w.UpdateConnectionContext(context.Background(), workflowId, connectionSourceNodeName, connection)
+}
+
+// UpdateConnectionContext is like UpdateConnection but binds every request to ctx
+func (w *Workflows) UpdateConnectionContext(ctx context.Context, workflowId string, connectionSourceNodeName string, connection N8nConnection) (N8nConnection, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return N8nConnection{}, err
@@ -112,7 +138,7 @@ func (w *Workflows) UpdateConnection(workflowId string, connectionSourceNodeName
 
 	workflow.Connections = finalConnections
 
-	_, err = w.UpdateWorkflow(workflowId, workflow)
+	_, err = w.UpdateWorkflowContext(ctx, workflowId, workflow)
 
 	if err != nil {
 		return N8nConnection{}, err
@@ -121,67 +147,76 @@ func (w *Workflows) UpdateConnection(workflowId string, connectionSourceNodeName
 	return connection, nil
 }
 
-func (w *Workflows) ParseConnectionsToObject(workflow map[string]interface{}) ([]N8nConnection, error) {
-	var finalConnections []N8nConnection
-	var tmpConnection N8nConnection
-	var tmpOutput N8nConnectionOutput
-
-	for k, v := range workflow {
-		if k == "connections" {
-			for sourceNodeName, connection := range v.(map[string]interface{}) {
-				tmpConnection.SourceNodeName = sourceNodeName // Run workflow
-				for connectionType, outputs := range connection.(map[string]interface{}) {
-					tmpConnection.ConnectionType = connectionType // main
-					for outputIndex, destinationNodes := range outputs.([]interface{}) {
-						tmpOutput.OutputIndex = outputIndex // 0
-						for _, destinationNode := range destinationNodes.([]interface{}) {
-							tmpOutput.DestinationNodeName = destinationNode.(map[string]interface{})["node"].(string)         // Loop over queries
-							tmpOutput.DestinationNodeInputIndex = destinationNode.(map[string]interface{})["index"].(float64) // 0
-							tmpOutput.DestinationNodeInputType = destinationNode.(map[string]interface{})["type"].(string)    // main
-						}
-						tmpConnection.Outputs = append(tmpConnection.Outputs, tmpOutput)
-						tmpOutput = N8nConnectionOutput{}
-					}
-					finalConnections = append(finalConnections, tmpConnection)
-					tmpConnection = N8nConnection{}
-				}
-			}
-		}
+// Connect links an output of the node named from to an input of the node named
+// to, keeping the other connections of the output. connectionType defaults to main.
+// Like Edit, it reads and saves the workflow once without detecting concurrent changes
+func (w *Workflows) Connect(workflowId string, from string, fromOutput int, to string, toInput int, connectionType string) (bool, error) {
+	return w.ConnectContext(context.Background(), workflowId, from, fromOutput, to, toInput, connectionType)
+}
+
+// ConnectContext is like Connect but binds every request to ctx
+func (w *Workflows) ConnectContext(ctx context.Context, workflowId string, from string, fromOutput int, to string, toInput int, connectionType string) (bool, error) {
+	return w.editEdge(ctx, workflowId, func(draft *WorkflowDraft) error {
+		return draft.Connect(from, fromOutput, to, toInput, connectionType)
+	})
+}
+
+// Disconnect removes the link between an output of the node named from and an
+// input of the node named to, keeping the other connections of the output.
+// connectionType defaults to main. Like Edit, it reads and saves the workflow
+// once without detecting concurrent changes
+func (w *Workflows) Disconnect(workflowId string, from string, fromOutput int, to string, toInput int, connectionType string) (bool, error) {
+	return w.DisconnectContext(context.Background(), workflowId, from, fromOutput, to, toInput, connectionType)
+}
+
+// DisconnectContext is like Disconnect but binds every request to ctx
+func (w *Workflows) DisconnectContext(ctx context.Context, workflowId string, from string, fromOutput int, to string, toInput int, connectionType string) (bool, error) {
+	return w.editEdge(ctx, workflowId, func(draft *WorkflowDraft) error {
+		return draft.Disconnect(from, fromOutput, to, toInput, connectionType)
+	})
+}
+
+// newEdge builds the edge between two nodes, using the same connection type on both ends
+func newEdge(from string, fromOutput int, to string, toInput int, connectionType string) N8nConnectionEdge {
+	if connectionType == "" {
+		connectionType = "main"
+	}
+	return N8nConnectionEdge{
+		Source:      from,
+		Type:        connectionType,
+		OutputIndex: fromOutput,
+		Target:      N8nConnectionTarget{Node: to, Type: connectionType, Index: toInput},
+	}
+}
+
+// editEdge applies a single connection change to a workflow
+func (w *Workflows) editEdge(ctx context.Context, workflowId string, edit func(*WorkflowDraft) error) (bool, error) {
+	_, err := w.EditContext(ctx, workflowId, edit)
+
+	if err != nil {
+		return false, err
 	}
 
-	return finalConnections, nil
+	return true, nil
 }
 
-func (w *Workflows) ParseConnectionsToMap(connections []N8nConnection) (map[string]interface{}, error) {
-	finalConnections := make(map[string]interface{})
-	var tmpOutputs = make([]interface{}, 1)
-	var tmpOutput = make([]interface{}, 1)
-
-	for _, connection := range connections {
-		for _, output := range connection.Outputs {
-			if output.DestinationNodeName == "" {
-				tmpOutput = append(tmpOutput, make(map[string]interface{}))
-			} else {
-				tmpOutput = append(tmpOutput, map[string]interface{}{
-					"node":  output.DestinationNodeName,
-					"index": output.DestinationNodeInputIndex,
-					"type":  output.DestinationNodeInputType,
-				})
-			}
-
-			if len(tmpOutputs) < output.OutputIndex+1 {
-				tmpOutputs = append(tmpOutputs, make([]interface{}, output.OutputIndex+1-len(tmpOutputs))...)
-			}
-
-			tmpOutputs = append(tmpOutputs[:output.OutputIndex+1], tmpOutputs[output.OutputIndex:]...)
-			tmpOutputs[output.OutputIndex] = utils.RemoveEmptyInterfaces(tmpOutput)
-			tmpOutput = []interface{}{}
-		}
-		sourceNodeMap := make(map[string]interface{})
-		sourceNodeMap[connection.ConnectionType] = utils.RemoveEmptyInterfaces(tmpOutputs)
-		finalConnections[connection.SourceNodeName] = sourceNodeMap
-		tmpOutputs = []interface{}{}
+// ParseConnectionsToObject converts the connections map of a workflow decoded
+// as a generic map into one N8nConnection per source node and connection type
+func (w *Workflows) ParseConnectionsToObject(workflow map[string]interface{}) ([]N8nConnection, error) {
+	connections, ok := workflow["connections"].(map[string]interface{})
+	if !ok {
+		return nil, nil
 	}
 
-	return finalConnections, nil
+	graph, err := ParseConnectionGraph(connections)
+	if err != nil {
+		return nil, err
+	}
+
+	return graph.connections(), nil
+}
+
+// ParseConnectionsToMap converts connections to the connections map sent to n8n
+func (w *Workflows) ParseConnectionsToMap(connections []N8nConnection) (map[string]interface{}, error) {
+	return graphFromConnections(connections).ToMap(), nil
 }
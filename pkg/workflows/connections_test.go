@@ -372,3 +372,106 @@ func TestUpdateConnection(t *testing.T) {
 		})
 	}
 }
+
+// edgeWorkflow has a Webhook node feeding Log, and an Audit node without connections
+const edgeWorkflow = `{
+	"id": "1",
+	"name": "Edges",
+	"nodes": [
+		{"name": "Webhook", "type": "n8n-nodes-base.webhook", "typeVersion": 2, "position": [0, 0], "parameters": {}},
+		{"name": "Log", "type": "n8n-nodes-base.noOp", "typeVersion": 1, "position": [200, 0], "parameters": {}},
+		{"name": "Audit", "type": "n8n-nodes-base.noOp", "typeVersion": 1, "position": [200, 200], "parameters": {}}
+	],
+	"connections": {"Webhook": {"main": [[{"node": "Log", "type": "main", "index": 0}]]}},
+	"settings": {}
+}`
+
+func TestConnectAndDisconnect(t *testing.T) {
+	tests := []struct {
+		name                string
+		call                func(w *Workflows) (bool, error)
+		expectedConnections string
+		expectErrorIs       error
+		expectError         bool
+	}{
+		{
+			name: "connect keeps existing targets",
+			call: func(w *Workflows) (bool, error) {
+				return w.Connect("1", "Webhook", 0, "Audit", 0, "")
+			},
+			expectedConnections: `{"Webhook": {"main": [[{"node": "Log", "type": "main", "index": 0}, {"node": "Audit", "type": "main", "index": 0}]]}}`,
+		},
+		{
+			name: "connect another connection type",
+			call: func(w *Workflows) (bool, error) {
+				return w.Connect("1", "Webhook", 0, "Audit", 0, "ai_tool")
+			},
+			expectedConnections: `{"Webhook": {
+				"main": [[{"node": "Log", "type": "main", "index": 0}]],
+				"ai_tool": [[{"node": "Audit", "type": "ai_tool", "index": 0}]]
+			}}`,
+		},
+		{
+			name: "connect existing edge",
+			call: func(w *Workflows) (bool, error) {
+				return w.Connect("1", "Webhook", 0, "Log", 0, "main")
+			},
+			expectError: true,
+		},
+		{
+			name: "connect unknown node",
+			call: func(w *Workflows) (bool, error) {
+				return w.Connect("1", "Webhook", 0, "Missing", 0, "main")
+			},
+			expectErrorIs: client.ErrNotFound,
+		},
+		{
+			name: "disconnect last edge",
+			call: func(w *Workflows) (bool, error) {
+				return w.Disconnect("1", "Webhook", 0, "Log", 0, "")
+			},
+			expectedConnections: `{}`,
+		},
+		{
+			name: "disconnect missing edge",
+			call: func(w *Workflows) (bool, error) {
+				return w.Disconnect("1", "Webhook", 0, "Audit", 0, "")
+			},
+			expectErrorIs: client.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var putBody map[string]json.RawMessage
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				if r.Method == "PUT" {
+					json.NewDecoder(r.Body).Decode(&putBody)
+				}
+				w.Write([]byte(edgeWorkflow))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			w := NewWorkflows(c)
+
+			success, err := tt.call(w)
+
+			if tt.expectErrorIs != nil {
+				assert.ErrorIs(t, err, tt.expectErrorIs)
+				assert.Nil(t, putBody)
+				return
+			}
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, putBody)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, success)
+			assert.JSONEq(t, tt.expectedConnections, string(putBody["connections"]))
+			assert.NotContains(t, putBody, "id")
+		})
+	}
+}
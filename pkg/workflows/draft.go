@@ -0,0 +1,212 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+)
+
+// WorkflowDraft is an in memory copy of a workflow changed by Edit. Nodes are
+// referred to by name, as connections are
+type WorkflowDraft struct {
+	w        *Workflows
+	workflow N8nWorkflow
+	graph    N8nConnectionGraph
+}
+
+// Edit loads a workflow once, lets edit apply any number of changes to a draft
+// of it, validates the result and saves it with a single update. Nothing is
+// saved when edit returns an error. Concurrent changes are not detected, see
+// EditWithOptions to check the version at the cost of an extra request
+func (w *Workflows) Edit(id string, edit func(*WorkflowDraft) error) (N8nWorkflow, error) {
+	return w.EditContext(context.Background(), id, edit)
+}
+
+// EditContext is like Edit but binds every request to ctx
+func (w *Workflows) EditContext(ctx context.Context, id string, edit func(*WorkflowDraft) error) (N8nWorkflow, error) {
+	return w.EditWithOptionsContext(ctx, id, EditOptions{}, edit)
+}
+
+// newDraft builds a draft of a deep copy of workflow, so that changes made in
+// place to the maps of a node, such as its parameters, do not reach workflow
+func (w *Workflows) newDraft(workflow N8nWorkflow) (*WorkflowDraft, error) {
+	graph, err := workflow.ConnectionGraph()
+	if err != nil {
+		return nil, err
+	}
+
+	jsonWorkflow, err := json.Marshal(workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	var draftWorkflow N8nWorkflow
+	if err := json.Unmarshal(jsonWorkflow, &draftWorkflow); err != nil {
+		return nil, err
+	}
+
+	return &WorkflowDraft{w: w, workflow: draftWorkflow, graph: graph}, nil
+}
+
+// Workflow returns the workflow as currently edited
+func (d *WorkflowDraft) Workflow() N8nWorkflow {
+	workflow := d.workflow
+	workflow.Nodes = append([]N8nNode(nil), d.workflow.Nodes...)
+	workflow.ConnectionsMap = d.graph.ToMap()
+	workflow.Connections = d.graph.connections()
+	return workflow
+}
+
+// SetName renames the workflow
+func (d *WorkflowDraft) SetName(name string) {
+	d.workflow.Name = name
+}
+
+// Settings returns the settings of the draft, changes made through the pointer are saved
+func (d *WorkflowDraft) Settings() *N8nWorkflowSettings {
+	return &d.workflow.Settings
+}
+
+// Graph returns the connections of the draft, changes made to it are saved
+func (d *WorkflowDraft) Graph() N8nConnectionGraph {
+	return d.graph
+}
+
+// Node returns the node with the given name
+func (d *WorkflowDraft) Node(name string) (N8nNode, bool) {
+	index := d.nodeIndex(name)
+	if index < 0 {
+		return N8nNode{}, false
+	}
+	return d.workflow.Nodes[index], true
+}
+
+// AddNode adds a node to the draft
+func (d *WorkflowDraft) AddNode(node N8nNode) error {
+	if err := d.w.validateNodeInput(node); err != nil {
+		return err
+	}
+
+	if d.nodeIndex(node.Name) >= 0 {
+		return fmt.Errorf("node %s already exists, use a different name", node.Name)
+	}
+
+	d.workflow.Nodes = append(d.workflow.Nodes, node)
+	return nil
+}
+
+// UpdateNode merges update into the node with the given name. Renaming the node
+// also renames it in every connection
+func (d *WorkflowDraft) UpdateNode(name string, update N8nNode) error {
+	if err := d.w.validateNodeInput(update); err != nil {
+		return err
+	}
+
+	index := d.nodeIndex(name)
+	if index < 0 {
+		return fmt.Errorf("node %s not found in draft: %w", name, client.ErrNotFound)
+	}
+
+	if update.Name != name {
+		if d.nodeIndex(update.Name) >= 0 {
+			return fmt.Errorf("node %s already exists, use a different name", update.Name)
+		}
+		d.graph.RenameNode(name, update.Name)
+	}
+
+	d.workflow.Nodes[index] = d.w.combineNodes(d.workflow.Nodes[index], update)
+	return nil
+}
+
+// RemoveNode removes the node with the given name and all its connections
+func (d *WorkflowDraft) RemoveNode(name string) error {
+	index := d.nodeIndex(name)
+	if index < 0 {
+		return fmt.Errorf("node %s not found in draft: %w", name, client.ErrNotFound)
+	}
+
+	d.workflow.Nodes = append(d.workflow.Nodes[:index], d.workflow.Nodes[index+1:]...)
+	d.graph.RemoveNode(name)
+	return nil
+}
+
+// Connect links an output of the node named from to an input of the node named
+// to. connectionType defaults to main
+func (d *WorkflowDraft) Connect(from string, fromOutput int, to string, toInput int, connectionType string) error {
+	if fromOutput < 0 || toInput < 0 {
+		return fmt.Errorf("output and input indexes should not be negative")
+	}
+
+	edge := newEdge(from, fromOutput, to, toInput, connectionType)
+	if err := d.checkEdgeNodes(edge); err != nil {
+		return err
+	}
+
+	if !d.graph.AddEdge(edge) {
+		return fmt.Errorf("connection from %s output %d to %s input %d already exists", from, fromOutput, to, toInput)
+	}
+	return nil
+}
+
+// Disconnect removes the link between an output of the node named from and an
+// input of the node named to. connectionType defaults to main
+func (d *WorkflowDraft) Disconnect(from string, fromOutput int, to string, toInput int, connectionType string) error {
+	edge := newEdge(from, fromOutput, to, toInput, connectionType)
+	if err := d.checkEdgeNodes(edge); err != nil {
+		return err
+	}
+
+	if !d.graph.RemoveEdge(edge) {
+		return fmt.Errorf("connection from %s output %d to %s input %d not found: %w", from, fromOutput, to, toInput, client.ErrNotFound)
+	}
+	return nil
+}
+
+// checkEdgeNodes checks both nodes of edge are part of the draft
+func (d *WorkflowDraft) checkEdgeNodes(edge N8nConnectionEdge) error {
+	for _, name := range []string{edge.Source, edge.Target.Node} {
+		if d.nodeIndex(name) < 0 {
+			return fmt.Errorf("node %s not found in draft: %w", name, client.ErrNotFound)
+		}
+	}
+	return nil
+}
+
+// validate checks node names are set and unique, and every connection links existing nodes
+func (d *WorkflowDraft) validate() error {
+	names := make(map[string]bool, len(d.workflow.Nodes))
+	for _, node := range d.workflow.Nodes {
+		if node.Name == "" {
+			return fmt.Errorf("node name should not be empty")
+		}
+		if node.Type == "" {
+			return fmt.Errorf("type of node %s should not be empty", node.Name)
+		}
+		if names[node.Name] {
+			return fmt.Errorf("node name %s is used more than once", node.Name)
+		}
+		names[node.Name] = true
+	}
+
+	for _, edge := range d.graph.Edges() {
+		if !names[edge.Source] {
+			return fmt.Errorf("connection from unknown node %s", edge.Source)
+		}
+		if !names[edge.Target.Node] {
+			return fmt.Errorf("connection from %s to unknown node %s", edge.Source, edge.Target.Node)
+		}
+	}
+
+	return nil
+}
+
+func (d *WorkflowDraft) nodeIndex(name string) int {
+	for i, node := range d.workflow.Nodes {
+		if node.Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,163 @@
+package workflows
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEdit(t *testing.T) {
+	tests := []struct {
+		name                string
+		edit                func(d *WorkflowDraft) error
+		expectError         bool
+		expectedRequests    []string
+		expectedNodes       []string
+		expectedConnections string
+	}{
+		{
+			name: "many changes in a single update",
+			edit: func(d *WorkflowDraft) error {
+				d.SetName("Edited")
+				d.Settings().ExecutionTimeout = 60
+				for _, name := range []string{"Fetch", "Transform", "Store"} {
+					if err := d.AddNode(N8nNode{Name: name, Type: "n8n-nodes-base.noOp", Position: []int{0, 0}}); err != nil {
+						return err
+					}
+				}
+				if err := d.RemoveNode("Audit"); err != nil {
+					return err
+				}
+				if err := d.Disconnect("Webhook", 0, "Log", 0, ""); err != nil {
+					return err
+				}
+				for _, link := range [][2]string{{"Webhook", "Fetch"}, {"Fetch", "Transform"}, {"Transform", "Store"}, {"Transform", "Log"}} {
+					if err := d.Connect(link[0], 0, link[1], 0, ""); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			expectedRequests: []string{"GET /workflows/1", "PUT /workflows/1"},
+			expectedNodes:    []string{"Webhook", "Log", "Fetch", "Transform", "Store"},
+			expectedConnections: `{
+				"Webhook": {"main": [[{"node": "Fetch", "type": "main", "index": 0}]]},
+				"Fetch": {"main": [[{"node": "Transform", "type": "main", "index": 0}]]},
+				"Transform": {"main": [[{"node": "Store", "type": "main", "index": 0}, {"node": "Log", "type": "main", "index": 0}]]}
+			}`,
+		},
+		{
+			name: "renaming a node renames its connections",
+			edit: func(d *WorkflowDraft) error {
+				return d.UpdateNode("Log", N8nNode{Name: "Logger", Type: "n8n-nodes-base.noOp", Position: []int{200, 0}})
+			},
+			expectedRequests:    []string{"GET /workflows/1", "PUT /workflows/1"},
+			expectedNodes:       []string{"Webhook", "Logger", "Audit"},
+			expectedConnections: `{"Webhook": {"main": [[{"node": "Logger", "type": "main", "index": 0}]]}}`,
+		},
+		{
+			name: "error from edit saves nothing",
+			edit: func(d *WorkflowDraft) error {
+				if err := d.RemoveNode("Log"); err != nil {
+					return err
+				}
+				return d.Connect("Webhook", 0, "Log", 0, "")
+			},
+			expectError:      true,
+			expectedRequests: []string{"GET /workflows/1"},
+		},
+		{
+			name: "invalid draft saves nothing",
+			edit: func(d *WorkflowDraft) error {
+				d.Graph().AddEdge(N8nConnectionEdge{Source: "Webhook", Type: "main", Target: N8nConnectionTarget{Node: "Missing", Type: "main"}})
+				return nil
+			},
+			expectError:      true,
+			expectedRequests: []string{"GET /workflows/1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			var putBody N8nWorkflow
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				w.WriteHeader(http.StatusOK)
+				if r.Method == "PUT" {
+					json.NewDecoder(r.Body).Decode(&putBody)
+				}
+				w.Write([]byte(edgeWorkflow))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			w := NewWorkflows(c)
+
+			_, err := w.Edit("1", tt.edit)
+
+			assert.Equal(t, tt.expectedRequests, requests)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var names []string
+			for _, node := range putBody.Nodes {
+				names = append(names, node.Name)
+			}
+			assert.Equal(t, tt.expectedNodes, names)
+
+			connections, _ := json.Marshal(putBody.ConnectionsMap)
+			assert.JSONEq(t, tt.expectedConnections, string(connections))
+		})
+	}
+}
+
+func TestEditDraftSettingsAndName(t *testing.T) {
+	var putBody N8nWorkflow
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.Method == "PUT" {
+			json.NewDecoder(r.Body).Decode(&putBody)
+		}
+		w.Write([]byte(edgeWorkflow))
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	w := NewWorkflows(c)
+
+	_, err := w.Edit("1", func(d *WorkflowDraft) error {
+		d.SetName("Renamed")
+		d.Settings().Timezone = "Europe/Madrid"
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Renamed", putBody.Name)
+	assert.Equal(t, "Europe/Madrid", putBody.Settings.Timezone)
+}
+
+func TestWorkflowDraftNodeErrors(t *testing.T) {
+	var workflow N8nWorkflow
+	assert.NoError(t, json.Unmarshal([]byte(edgeWorkflow), &workflow))
+
+	w := &Workflows{}
+	draft, err := w.newDraft(workflow)
+	assert.NoError(t, err)
+
+	assert.Error(t, draft.AddNode(N8nNode{Name: "Log", Type: "n8n-nodes-base.noOp", Position: []int{0, 0}}))
+	assert.True(t, errors.Is(draft.RemoveNode("Missing"), client.ErrNotFound))
+	assert.Error(t, draft.UpdateNode("Webhook", N8nNode{Name: "Log", Type: "n8n-nodes-base.noOp", Position: []int{0, 0}}))
+
+	// the loaded workflow is not changed by the draft
+	assert.NoError(t, draft.RemoveNode("Audit"))
+	assert.Len(t, workflow.Nodes, 3)
+}
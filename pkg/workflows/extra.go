@@ -0,0 +1,141 @@
+package workflows
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// readOnlyWorkflowFields are the workflow fields returned by n8n that are
+// rejected when a workflow is written back
+var readOnlyWorkflowFields = []string{"active", "createdAt", "updatedAt", "isArchived", "shared", "triggerCount"}
+
+// UnmarshalJSON decodes a workflow, keeping the fields it does not declare in Extra
+func (wf *N8nWorkflow) UnmarshalJSON(data []byte) error {
+	type workflow N8nWorkflow
+	return decodeWithExtra(data, (*workflow)(wf), &wf.Extra)
+}
+
+// MarshalJSON encodes a workflow, including the fields kept in Extra
+func (wf N8nWorkflow) MarshalJSON() ([]byte, error) {
+	type workflow N8nWorkflow
+	return encodeWithExtra(workflow(wf), wf.Extra)
+}
+
+// UnmarshalJSON decodes a node, keeping the fields it does not declare in Extra
+func (n *N8nNode) UnmarshalJSON(data []byte) error {
+	type node N8nNode
+	return decodeWithExtra(data, (*node)(n), &n.Extra)
+}
+
+// MarshalJSON encodes a node, including the fields kept in Extra. Parameters
+// are always sent as n8n requires them on every node
+func (n N8nNode) MarshalJSON() ([]byte, error) {
+	type node N8nNode
+	if n.Parameters == nil {
+		n.Parameters = map[string]interface{}{}
+	}
+	return encodeWithExtra(node(n), n.Extra)
+}
+
+// UnmarshalJSON decodes workflow settings, keeping the fields they do not declare in Extra
+func (s *N8nWorkflowSettings) UnmarshalJSON(data []byte) error {
+	type settings N8nWorkflowSettings
+	return decodeWithExtra(data, (*settings)(s), &s.Extra)
+}
+
+// MarshalJSON encodes workflow settings, including the fields kept in Extra
+func (s N8nWorkflowSettings) MarshalJSON() ([]byte, error) {
+	type settings N8nWorkflowSettings
+	return encodeWithExtra(settings(s), s.Extra)
+}
+
+// decodeWithExtra unmarshals data into v, a pointer to a struct, and merges the
+// object keys not declared by the struct into extra. Declared fields sent with
+// an explicit zero value, such as "saveManualExecutions": false, are kept in
+// extra as well, as omitempty would otherwise drop them when encoding
+func decodeWithExtra(data []byte, v interface{}, extra *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	value := reflect.ValueOf(v).Elem()
+	known := declaredFields(value.Type())
+	for key, raw := range fields {
+		if index, ok := known[key]; ok && (index < 0 || !omittedZero(value.Field(index), raw)) {
+			continue
+		}
+		if *extra == nil {
+			*extra = make(map[string]json.RawMessage)
+		}
+		(*extra)[key] = raw
+	}
+
+	return nil
+}
+
+// omittedZero reports whether field holds the explicit zero value raw of a
+// boolean or number that encoding would omit
+func omittedZero(field reflect.Value, raw json.RawMessage) bool {
+	switch field.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return field.IsZero() && string(raw) != "null"
+	}
+	return false
+}
+
+// encodeWithExtra marshals v and adds the extra fields that v does not set itself
+func encodeWithExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	for key, value := range extra {
+		if _, ok := fields[key]; !ok {
+			fields[key] = value
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// declaredFields returns the JSON keys of the fields of a struct type that
+// omit their zero value, mapped to the index of the field. Fields always
+// encoded, such as name, are mapped to -1
+func declaredFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Name
+		index := -1
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			if tag == "-" {
+				continue
+			}
+			tagName, options, _ := strings.Cut(tag, ",")
+			if tagName != "" {
+				name = tagName
+			}
+			if slices.Contains(strings.Split(options, ","), "omitempty") {
+				index = i
+			}
+		}
+		fields[name] = index
+	}
+	return fields
+}
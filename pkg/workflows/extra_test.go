@@ -0,0 +1,182 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// fetchedWorkflow is a workflow as returned by n8n, with fields the structs do not declare
+const fetchedWorkflow = `{
+	"id": "1",
+	"name": "Invoices",
+	"active": false,
+	"createdAt": "2024-01-01T00:00:00.000Z",
+	"updatedAt": "2024-02-01T00:00:00.000Z",
+	"versionId": "7b2c9a4e-1f3d-4c55-9a11-2f0e8d7c6b5a",
+	"meta": {"templateCredsSetupCompleted": true},
+	"pinData": {"Webhook": [{"json": {"invoice": 42}}]},
+	"staticData": {"node:Cron": {"recurrenceRules": []}},
+	"tags": [{"id": "t1", "name": "billing", "createdAt": "2024-01-01T00:00:00.000Z", "updatedAt": "2024-01-01T00:00:00.000Z"}],
+	"nodes": [
+		{
+			"id": "a1",
+			"name": "Webhook",
+			"type": "n8n-nodes-base.webhook",
+			"typeVersion": 2,
+			"position": [0, 0],
+			"webhookId": "a889d2ae-2159-402f-b326-5f61e90f602e",
+			"parameters": {"path": "invoices"}
+		},
+		{
+			"id": "a2",
+			"name": "HTTP Request",
+			"type": "n8n-nodes-base.httpRequest",
+			"typeVersion": 4.2,
+			"position": [220, 0],
+			"onError": "continueErrorOutput",
+			"disabled": false,
+			"retryOnFail": false,
+			"maxTries": 0,
+			"parameters": {},
+			"extendsCredential": "oAuth2Api",
+			"credentials": {"httpHeaderAuth": {"id": "c1", "name": "Internal API"}}
+		}
+	],
+	"connections": {
+		"Webhook": {"main": [[{"node": "HTTP Request", "type": "main", "index": 0}]]}
+	},
+	"settings": {"executionOrder": "v1", "callerPolicy": "workflowsFromSameOwner", "saveExecutionProgress": true, "saveManualExecutions": false}
+}`
+
+func TestWorkflowExtraFields(t *testing.T) {
+	var workflow N8nWorkflow
+	err := json.Unmarshal([]byte(fetchedWorkflow), &workflow)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "7b2c9a4e-1f3d-4c55-9a11-2f0e8d7c6b5a", workflow.VersionId)
+	assert.JSONEq(t, `{"Webhook": [{"json": {"invoice": 42}}]}`, string(workflow.Extra["pinData"]))
+	assert.NotContains(t, workflow.Extra, "name")
+	assert.JSONEq(t, `"oAuth2Api"`, string(workflow.Nodes[1].Extra["extendsCredential"]))
+	assert.Nil(t, workflow.Nodes[0].Extra)
+	assert.JSONEq(t, `"workflowsFromSameOwner"`, string(workflow.Settings.Extra["callerPolicy"]))
+	assert.JSONEq(t, `{"node:Cron": {"recurrenceRules": []}}`, string(workflow.StaticData))
+
+	encoded, err := json.Marshal(workflow)
+	assert.NoError(t, err)
+	assert.JSONEq(t, fetchedWorkflow, string(encoded))
+}
+
+func TestUpdateWorkflowKeepsUnknownFields(t *testing.T) {
+	var putBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.Method == "PUT" {
+			putBody, _ = io.ReadAll(r.Body)
+		}
+		w.Write([]byte(fetchedWorkflow))
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	w := NewWorkflows(c)
+
+	_, err := w.UpdateWorkflow("1", N8nWorkflow{Name: "Invoices"})
+	assert.NoError(t, err)
+
+	// the workflow is sent back unchanged, without its read only fields
+	var expected map[string]interface{}
+	json.Unmarshal([]byte(fetchedWorkflow), &expected)
+	for _, field := range []string{"id", "active", "tags", "createdAt", "updatedAt"} {
+		delete(expected, field)
+	}
+	expectedBody, _ := json.Marshal(expected)
+
+	assert.JSONEq(t, string(expectedBody), string(putBody))
+	assert.NotContains(t, string(putBody), `"active"`)
+}
+
+func TestExtraDoesNotOverrideDeclaredFields(t *testing.T) {
+	node := N8nNode{
+		Name:  "Set",
+		Type:  "n8n-nodes-base.set",
+		Extra: map[string]json.RawMessage{"name": json.RawMessage(`"Other"`), "color": json.RawMessage(`"red"`)},
+	}
+
+	encoded, err := json.Marshal(node)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Set","type":"n8n-nodes-base.set","typeVersion":0,"position":null,"parameters":{},"color":"red"}`, string(encoded))
+}
+
+func TestWorkflowExplicitZeroValues(t *testing.T) {
+	var workflow N8nWorkflow
+	err := json.Unmarshal([]byte(fetchedWorkflow), &workflow)
+	assert.NoError(t, err)
+
+	assert.False(t, workflow.Settings.SaveManualExecutions)
+	assert.JSONEq(t, `false`, string(workflow.Settings.Extra["saveManualExecutions"]))
+	assert.JSONEq(t, `false`, string(workflow.Nodes[1].Extra["retryOnFail"]))
+	assert.NotContains(t, workflow.Settings.Extra, "saveExecutionProgress")
+
+	// a value set after decoding takes precedence over the explicit zero
+	workflow.Settings.SaveManualExecutions = true
+	workflow.Nodes[1].RetryOnFail = true
+	workflow.Nodes[1].MaxTries = 3
+
+	var encoded []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoded, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(fetchedWorkflow))
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	_, err = NewWorkflows(c).putWorkflow(context.Background(), "1", workflow)
+	assert.NoError(t, err)
+
+	var fields struct {
+		Active   *bool `json:"active"`
+		Settings struct {
+			SaveManualExecutions  *bool `json:"saveManualExecutions"`
+			SaveExecutionProgress *bool `json:"saveExecutionProgress"`
+		} `json:"settings"`
+		Nodes []struct {
+			Disabled    *bool `json:"disabled"`
+			RetryOnFail *bool `json:"retryOnFail"`
+			MaxTries    *int  `json:"maxTries"`
+		} `json:"nodes"`
+	}
+	assert.NoError(t, json.Unmarshal(encoded, &fields))
+	// active is read only and never sent back
+	assert.Nil(t, fields.Active)
+	assert.Equal(t, true, *fields.Settings.SaveManualExecutions)
+	assert.Equal(t, true, *fields.Settings.SaveExecutionProgress)
+	assert.Nil(t, fields.Nodes[0].Disabled)
+	assert.Equal(t, false, *fields.Nodes[1].Disabled)
+	assert.Equal(t, true, *fields.Nodes[1].RetryOnFail)
+	assert.Equal(t, 3, *fields.Nodes[1].MaxTries)
+}
+
+func TestGetWorkflowWithStaticData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(fetchedWorkflow))
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	w := NewWorkflows(c)
+
+	workflow, err := w.GetWorkflow("1")
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"node:Cron": {"recurrenceRules": []}}`, string(workflow.StaticData))
+}
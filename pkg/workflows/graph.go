@@ -0,0 +1,285 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// N8nConnectionTarget is the input of a node an output is connected to
+type N8nConnectionTarget struct {
+	Node  string `json:"node"`
+	Type  string `json:"type"`
+	Index int    `json:"index"`
+}
+
+// N8nConnectionGraph mirrors the connections map of a workflow: source node
+// name, then connection type (main, ai_languageModel, ai_tool...), then output
+// index, then every target connected to that output. Empty outputs are kept so
+// output indexes never shift
+type N8nConnectionGraph map[string]map[string][][]N8nConnectionTarget
+
+// N8nConnectionEdge is a single link from an output of a node to an input of another
+type N8nConnectionEdge struct {
+	Source      string
+	Type        string
+	OutputIndex int
+	Target      N8nConnectionTarget
+}
+
+// ParseConnectionGraph builds a graph from the connections map of a workflow
+func ParseConnectionGraph(connections map[string]interface{}) (N8nConnectionGraph, error) {
+	graph := N8nConnectionGraph{}
+	if len(connections) == 0 {
+		return graph, nil
+	}
+
+	raw, err := json.Marshal(connections)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(raw, &graph)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workflow connections: %v", err)
+	}
+
+	return graph, nil
+}
+
+// ConnectionGraph returns the connections of the workflow as a graph
+func (wf N8nWorkflow) ConnectionGraph() (N8nConnectionGraph, error) {
+	return ParseConnectionGraph(wf.ConnectionsMap)
+}
+
+// GetConnectionGraph retrieves the connections of a workflow as a graph
+func (w *Workflows) GetConnectionGraph(workflowId string) (N8nConnectionGraph, error) {
+	return w.GetConnectionGraphContext(context.Background(), workflowId)
+}
+
+// GetConnectionGraphContext is like GetConnectionGraph but binds the request to ctx
+func (w *Workflows) GetConnectionGraphContext(ctx context.Context, workflowId string) (N8nConnectionGraph, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return workflow.ConnectionGraph()
+}
+
+// ToMap converts the graph to the connections map sent to n8n
+func (g N8nConnectionGraph) ToMap() map[string]interface{} {
+	connections := make(map[string]interface{}, len(g))
+	for source, types := range g {
+		sourceMap := make(map[string]interface{}, len(types))
+		for connectionType, outputs := range types {
+			outputList := make([]interface{}, 0, len(outputs))
+			for _, targets := range outputs {
+				targetList := make([]interface{}, 0, len(targets))
+				for _, target := range targets {
+					targetList = append(targetList, map[string]interface{}{
+						"node":  target.Node,
+						"type":  target.Type,
+						"index": target.Index,
+					})
+				}
+				outputList = append(outputList, targetList)
+			}
+			sourceMap[connectionType] = outputList
+		}
+		connections[source] = sourceMap
+	}
+	return connections
+}
+
+// Targets returns the targets connected to an output of a node
+func (g N8nConnectionGraph) Targets(source, connectionType string, outputIndex int) []N8nConnectionTarget {
+	outputs := g[source][connectionType]
+	if outputIndex < 0 || outputIndex >= len(outputs) {
+		return nil
+	}
+	return outputs[outputIndex]
+}
+
+// Edges returns every edge of the graph, ordered by source, type and output
+func (g N8nConnectionGraph) Edges() []N8nConnectionEdge {
+	var edges []N8nConnectionEdge
+	for _, source := range sortedKeys(g) {
+		for _, connectionType := range sortedKeys(g[source]) {
+			for outputIndex, targets := range g[source][connectionType] {
+				for _, target := range targets {
+					edges = append(edges, N8nConnectionEdge{Source: source, Type: connectionType, OutputIndex: outputIndex, Target: target})
+				}
+			}
+		}
+	}
+	return edges
+}
+
+// HasEdge reports whether the graph holds the edge
+func (g N8nConnectionGraph) HasEdge(edge N8nConnectionEdge) bool {
+	for _, target := range g.Targets(edge.Source, edge.Type, edge.OutputIndex) {
+		if target == edge.Target {
+			return true
+		}
+	}
+	return false
+}
+
+// AddEdge adds the edge to the graph, keeping the other targets of the output.
+// It returns false when the edge already exists
+func (g N8nConnectionGraph) AddEdge(edge N8nConnectionEdge) bool {
+	if edge.OutputIndex < 0 || g.HasEdge(edge) {
+		return false
+	}
+
+	if g[edge.Source] == nil {
+		g[edge.Source] = make(map[string][][]N8nConnectionTarget)
+	}
+	outputs := g[edge.Source][edge.Type]
+	for len(outputs) <= edge.OutputIndex {
+		outputs = append(outputs, []N8nConnectionTarget{})
+	}
+	outputs[edge.OutputIndex] = append(outputs[edge.OutputIndex], edge.Target)
+	g[edge.Source][edge.Type] = outputs
+
+	return true
+}
+
+// RemoveEdge removes the edge from the graph, keeping the other targets of the
+// output. It returns false when the edge does not exist
+func (g N8nConnectionGraph) RemoveEdge(edge N8nConnectionEdge) bool {
+	if !g.HasEdge(edge) {
+		return false
+	}
+
+	outputs := g[edge.Source][edge.Type]
+	targets := outputs[edge.OutputIndex]
+	remaining := make([]N8nConnectionTarget, 0, len(targets))
+	for _, target := range targets {
+		if target != edge.Target {
+			remaining = append(remaining, target)
+		}
+	}
+	outputs[edge.OutputIndex] = remaining
+	g.prune(edge.Source, edge.Type)
+
+	return true
+}
+
+// RemoveNode removes every edge from or to a node
+func (g N8nConnectionGraph) RemoveNode(name string) {
+	delete(g, name)
+	for source, types := range g {
+		for connectionType, outputs := range types {
+			for i, targets := range outputs {
+				remaining := make([]N8nConnectionTarget, 0, len(targets))
+				for _, target := range targets {
+					if target.Node != name {
+						remaining = append(remaining, target)
+					}
+				}
+				outputs[i] = remaining
+			}
+			g.prune(source, connectionType)
+		}
+	}
+}
+
+// RenameNode renames a node in every edge from or to it
+func (g N8nConnectionGraph) RenameNode(oldName, newName string) {
+	if types, ok := g[oldName]; ok {
+		delete(g, oldName)
+		g[newName] = types
+	}
+	for _, types := range g {
+		for _, outputs := range types {
+			for _, targets := range outputs {
+				for i := range targets {
+					if targets[i].Node == oldName {
+						targets[i].Node = newName
+					}
+				}
+			}
+		}
+	}
+}
+
+// prune drops a connection type without any target, and the source when it has
+// no connection type left
+func (g N8nConnectionGraph) prune(source, connectionType string) {
+	for _, targets := range g[source][connectionType] {
+		if len(targets) > 0 {
+			return
+		}
+	}
+	delete(g[source], connectionType)
+	if len(g[source]) == 0 {
+		delete(g, source)
+	}
+}
+
+// connections converts the graph to the flat connection list of N8nWorkflow.
+// Outputs without targets are kept as outputs without destination
+func (g N8nConnectionGraph) connections() []N8nConnection {
+	var connections []N8nConnection
+	for _, source := range sortedKeys(g) {
+		for _, connectionType := range sortedKeys(g[source]) {
+			connection := N8nConnection{SourceNodeName: source, ConnectionType: connectionType}
+			for outputIndex, targets := range g[source][connectionType] {
+				if len(targets) == 0 {
+					connection.Outputs = append(connection.Outputs, N8nConnectionOutput{OutputIndex: outputIndex})
+				}
+				for _, target := range targets {
+					connection.Outputs = append(connection.Outputs, N8nConnectionOutput{
+						OutputIndex:               outputIndex,
+						DestinationNodeName:       target.Node,
+						DestinationNodeInputIndex: float64(target.Index),
+						DestinationNodeInputType:  target.Type,
+					})
+				}
+			}
+			connections = append(connections, connection)
+		}
+	}
+	return connections
+}
+
+// graphFromConnections builds a graph from the flat connection list of N8nWorkflow
+func graphFromConnections(connections []N8nConnection) N8nConnectionGraph {
+	graph := N8nConnectionGraph{}
+	for _, connection := range connections {
+		if graph[connection.SourceNodeName] == nil {
+			graph[connection.SourceNodeName] = make(map[string][][]N8nConnectionTarget)
+		}
+		outputs := graph[connection.SourceNodeName][connection.ConnectionType]
+		for _, output := range connection.Outputs {
+			if output.OutputIndex < 0 {
+				continue
+			}
+			for len(outputs) <= output.OutputIndex {
+				outputs = append(outputs, []N8nConnectionTarget{})
+			}
+			if output.DestinationNodeName != "" {
+				outputs[output.OutputIndex] = append(outputs[output.OutputIndex], N8nConnectionTarget{
+					Node:  output.DestinationNodeName,
+					Type:  output.DestinationNodeInputType,
+					Index: int(output.DestinationNodeInputIndex),
+				})
+			}
+		}
+		graph[connection.SourceNodeName][connection.ConnectionType] = outputs
+	}
+	return graph
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,160 @@
+package workflows
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// agentConnections fans out an output and mixes main and AI connection types
+const agentConnections = `{
+	"Webhook": {"main": [[
+		{"node": "AI Agent", "type": "main", "index": 0},
+		{"node": "Log", "type": "main", "index": 0}
+	]]},
+	"If": {"main": [[], [{"node": "Log", "type": "main", "index": 0}]]},
+	"OpenAI Chat Model": {"ai_languageModel": [[{"node": "AI Agent", "type": "ai_languageModel", "index": 0}]]},
+	"Calculator": {"ai_tool": [[{"node": "AI Agent", "type": "ai_tool", "index": 0}]]},
+	"Memory": {
+		"ai_memory": [[{"node": "AI Agent", "type": "ai_memory", "index": 0}]],
+		"main": [[{"node": "Log", "type": "main", "index": 1}]]
+	}
+}`
+
+func parseAgentConnections(t *testing.T) N8nConnectionGraph {
+	var connections map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(agentConnections), &connections))
+
+	graph, err := ParseConnectionGraph(connections)
+	assert.NoError(t, err)
+
+	return graph
+}
+
+func TestConnectionGraphRoundTrip(t *testing.T) {
+	graph := parseAgentConnections(t)
+
+	assert.Equal(t, []N8nConnectionTarget{
+		{Node: "AI Agent", Type: "main", Index: 0},
+		{Node: "Log", Type: "main", Index: 0},
+	}, graph.Targets("Webhook", "main", 0))
+	assert.Empty(t, graph.Targets("If", "main", 0))
+	assert.Equal(t, []N8nConnectionTarget{{Node: "Log", Type: "main", Index: 0}}, graph.Targets("If", "main", 1))
+	assert.Len(t, graph.Edges(), 7)
+
+	encoded, err := json.Marshal(graph.ToMap())
+	assert.NoError(t, err)
+	assert.JSONEq(t, agentConnections, string(encoded))
+}
+
+func TestConnectionGraphEdges(t *testing.T) {
+	tests := []struct {
+		name     string
+		edit     func(g N8nConnectionGraph) bool
+		expected bool
+		check    func(t *testing.T, g N8nConnectionGraph)
+	}{
+		{
+			name: "add edge keeps other targets",
+			edit: func(g N8nConnectionGraph) bool {
+				return g.AddEdge(N8nConnectionEdge{Source: "Webhook", Type: "main", OutputIndex: 0, Target: N8nConnectionTarget{Node: "Audit", Type: "main"}})
+			},
+			expected: true,
+			check: func(t *testing.T, g N8nConnectionGraph) {
+				assert.Len(t, g.Targets("Webhook", "main", 0), 3)
+			},
+		},
+		{
+			name: "add edge on a new output pads outputs",
+			edit: func(g N8nConnectionGraph) bool {
+				return g.AddEdge(N8nConnectionEdge{Source: "Switch", Type: "main", OutputIndex: 2, Target: N8nConnectionTarget{Node: "Log", Type: "main"}})
+			},
+			expected: true,
+			check: func(t *testing.T, g N8nConnectionGraph) {
+				assert.Len(t, g["Switch"]["main"], 3)
+				assert.Empty(t, g.Targets("Switch", "main", 0))
+			},
+		},
+		{
+			name: "add existing edge",
+			edit: func(g N8nConnectionGraph) bool {
+				return g.AddEdge(N8nConnectionEdge{Source: "Webhook", Type: "main", OutputIndex: 0, Target: N8nConnectionTarget{Node: "Log", Type: "main"}})
+			},
+			expected: false,
+		},
+		{
+			name: "remove edge keeps other targets",
+			edit: func(g N8nConnectionGraph) bool {
+				return g.RemoveEdge(N8nConnectionEdge{Source: "Webhook", Type: "main", OutputIndex: 0, Target: N8nConnectionTarget{Node: "Log", Type: "main"}})
+			},
+			expected: true,
+			check: func(t *testing.T, g N8nConnectionGraph) {
+				assert.Equal(t, []N8nConnectionTarget{{Node: "AI Agent", Type: "main"}}, g.Targets("Webhook", "main", 0))
+			},
+		},
+		{
+			name: "remove last edge of a type",
+			edit: func(g N8nConnectionGraph) bool {
+				return g.RemoveEdge(N8nConnectionEdge{Source: "Memory", Type: "ai_memory", OutputIndex: 0, Target: N8nConnectionTarget{Node: "AI Agent", Type: "ai_memory"}})
+			},
+			expected: true,
+			check: func(t *testing.T, g N8nConnectionGraph) {
+				assert.NotContains(t, g["Memory"], "ai_memory")
+				assert.Contains(t, g["Memory"], "main")
+			},
+		},
+		{
+			name: "remove missing edge",
+			edit: func(g N8nConnectionGraph) bool {
+				return g.RemoveEdge(N8nConnectionEdge{Source: "Webhook", Type: "main", OutputIndex: 1, Target: N8nConnectionTarget{Node: "Log", Type: "main"}})
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := parseAgentConnections(t)
+
+			assert.Equal(t, tt.expected, tt.edit(graph))
+			if tt.check != nil {
+				tt.check(t, graph)
+			}
+		})
+	}
+}
+
+func TestConnectionGraphRemoveNode(t *testing.T) {
+	graph := parseAgentConnections(t)
+
+	graph.RemoveNode("AI Agent")
+
+	assert.NotContains(t, graph, "OpenAI Chat Model")
+	assert.NotContains(t, graph, "Calculator")
+	assert.NotContains(t, graph["Memory"], "ai_memory")
+	assert.Equal(t, []N8nConnectionTarget{{Node: "Log", Type: "main"}}, graph.Targets("Webhook", "main", 0))
+}
+
+func TestParseConnectionsToObjectFanOut(t *testing.T) {
+	var workflow map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"connections":`+agentConnections+`}`), &workflow))
+
+	w := &Workflows{}
+	connections, err := w.ParseConnectionsToObject(workflow)
+	assert.NoError(t, err)
+	assert.Len(t, connections, 6)
+
+	for _, connection := range connections {
+		if connection.SourceNodeName == "Webhook" {
+			assert.Len(t, connection.Outputs, 2)
+		}
+	}
+
+	connectionsMap, err := w.ParseConnectionsToMap(connections)
+	assert.NoError(t, err)
+
+	encoded, err := json.Marshal(connectionsMap)
+	assert.NoError(t, err)
+	assert.JSONEq(t, agentConnections, string(encoded))
+}
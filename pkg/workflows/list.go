@@ -0,0 +1,147 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ListWorkflowsOptions filters the workflows returned by ListWorkflows
+type ListWorkflowsOptions struct {
+	// Active only returns active or inactive workflows when set
+	Active *bool
+	// Tags only returns workflows having all the given tag names
+	Tags              []string
+	Name              string
+	ProjectId         string
+	ExcludePinnedData bool
+	// Limit sets the page size used while fetching every page
+	Limit int
+}
+
+// ListWorkflows retrieves every workflow matching the options
+func (w *Workflows) ListWorkflows(opts ListWorkflowsOptions) ([]N8nWorkflow, error) {
+	return w.ListWorkflowsContext(context.Background(), opts)
+}
+
+// ListWorkflowsContext is like ListWorkflows but binds every page request to ctx
+func (w *Workflows) ListWorkflowsContext(ctx context.Context, opts ListWorkflowsOptions) ([]N8nWorkflow, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/workflows?%s", w.Client.HostURL, opts.query().Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := w.Client.GetPaginatedContext(ctx, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var rawWorkflows []json.RawMessage
+	err = json.Unmarshal(resp, &rawWorkflows)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows := make([]N8nWorkflow, 0, len(rawWorkflows))
+	for _, rawWorkflow := range rawWorkflows {
+		workflow, err := w.decodeWorkflow(rawWorkflow)
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, workflow)
+	}
+
+	return workflows, nil
+}
+
+// FindWorkflows retrieves the workflows matching the options and keeps the ones
+// for which match returns true
+func (w *Workflows) FindWorkflows(opts ListWorkflowsOptions, match func(N8nWorkflow) bool) ([]N8nWorkflow, error) {
+	return w.FindWorkflowsContext(context.Background(), opts, match)
+}
+
+// FindWorkflowsContext is like FindWorkflows but binds every page request to ctx
+func (w *Workflows) FindWorkflowsContext(ctx context.Context, opts ListWorkflowsOptions, match func(N8nWorkflow) bool) ([]N8nWorkflow, error) {
+	workflows, err := w.ListWorkflowsContext(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []N8nWorkflow
+	for _, workflow := range workflows {
+		if match(workflow) {
+			matching = append(matching, workflow)
+		}
+	}
+
+	return matching, nil
+}
+
+// ContainsNodeType matches workflows with at least one node of the given type,
+// e.g. n8n-nodes-base.salesforce
+func ContainsNodeType(nodeType string) func(N8nWorkflow) bool {
+	return func(workflow N8nWorkflow) bool {
+		return workflow.HasNodeType(nodeType)
+	}
+}
+
+// UsesCredential matches workflows with at least one node using the given credential id
+func UsesCredential(credentialId string) func(N8nWorkflow) bool {
+	return func(workflow N8nWorkflow) bool {
+		return workflow.UsesCredential(credentialId)
+	}
+}
+
+// HasNodeType reports whether the workflow has a node of the given type
+func (wf N8nWorkflow) HasNodeType(nodeType string) bool {
+	for _, node := range wf.Nodes {
+		if node.Type == nodeType {
+			return true
+		}
+	}
+	return false
+}
+
+// UsesCredential reports whether a node of the workflow uses the given credential id
+func (wf N8nWorkflow) UsesCredential(credentialId string) bool {
+	for _, node := range wf.Nodes {
+		for _, credential := range node.Credentials {
+			details, ok := credential.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if id, ok := details["id"].(string); ok && id == credentialId {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// query builds the query string of a list request
+func (o ListWorkflowsOptions) query() url.Values {
+	q := url.Values{}
+	if o.Active != nil {
+		q.Set("active", strconv.FormatBool(*o.Active))
+	}
+	if len(o.Tags) > 0 {
+		q.Set("tags", strings.Join(o.Tags, ","))
+	}
+	if o.Name != "" {
+		q.Set("name", o.Name)
+	}
+	if o.ProjectId != "" {
+		q.Set("projectId", o.ProjectId)
+	}
+	if o.ExcludePinnedData {
+		q.Set("excludePinnedData", "true")
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return q
+}
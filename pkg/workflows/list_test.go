@@ -0,0 +1,174 @@
+package workflows
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// listWorkflowsServer serves two pages of workflows, the first using Salesforce
+func listWorkflowsServer(t *testing.T, expectedQuery map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/workflows", r.URL.Path)
+		for key, value := range expectedQuery {
+			assert.Equal(t, value, r.URL.Query().Get(key), key)
+		}
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("cursor") == "" {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": []map[string]interface{}{
+					{
+						"id":     "1",
+						"name":   "Sync accounts",
+						"active": true,
+						"nodes": []map[string]interface{}{
+							{
+								"name": "Salesforce",
+								"type": "n8n-nodes-base.salesforce",
+								"credentials": map[string]interface{}{
+									"salesforceOAuth2Api": map[string]interface{}{"id": "cred-sf", "name": "Salesforce account"},
+								},
+							},
+						},
+						"connections": map[string]interface{}{},
+					},
+				},
+				"nextCursor": "page2",
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": []map[string]interface{}{
+				{
+					"id":     "2",
+					"name":   "Notify",
+					"active": true,
+					"nodes": []map[string]interface{}{
+						{
+							"name": "Slack",
+							"type": "n8n-nodes-base.slack",
+							"credentials": map[string]interface{}{
+								"slackApi": map[string]interface{}{"id": "cred-slack", "name": "Slack account"},
+							},
+						},
+					},
+					"connections": map[string]interface{}{
+						"Slack": map[string]interface{}{
+							"main": []interface{}{
+								[]interface{}{
+									map[string]interface{}{"node": "Done", "type": "main", "index": 0},
+								},
+							},
+						},
+					},
+				},
+			},
+			"nextCursor": nil,
+		})
+	}))
+}
+
+func TestListWorkflows(t *testing.T) {
+	active := true
+
+	tests := []struct {
+		name          string
+		opts          ListWorkflowsOptions
+		expectedQuery map[string]string
+		expectedIds   []string
+	}{
+		{
+			name: "all filters",
+			opts: ListWorkflowsOptions{
+				Active:            &active,
+				Tags:              []string{"team:payments", "prod"},
+				Name:              "Sync",
+				ProjectId:         "p1",
+				ExcludePinnedData: true,
+				Limit:             1,
+			},
+			expectedQuery: map[string]string{
+				"active":            "true",
+				"tags":              "team:payments,prod",
+				"name":              "Sync",
+				"projectId":         "p1",
+				"excludePinnedData": "true",
+				"limit":             "1",
+			},
+			expectedIds: []string{"1", "2"},
+		},
+		{
+			name:          "no filters",
+			opts:          ListWorkflowsOptions{},
+			expectedQuery: map[string]string{"active": "", "tags": ""},
+			expectedIds:   []string{"1", "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := listWorkflowsServer(t, tt.expectedQuery)
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			w := NewWorkflows(c)
+
+			workflows, err := w.ListWorkflows(tt.opts)
+
+			assert.NoError(t, err)
+			var ids []string
+			for _, workflow := range workflows {
+				ids = append(ids, workflow.Id)
+			}
+			assert.Equal(t, tt.expectedIds, ids)
+			assert.Equal(t, "Slack", workflows[1].Connections[0].SourceNodeName)
+		})
+	}
+}
+
+func TestFindWorkflows(t *testing.T) {
+	tests := []struct {
+		name        string
+		match       func(N8nWorkflow) bool
+		expectedIds []string
+	}{
+		{
+			name:        "by node type",
+			match:       ContainsNodeType("n8n-nodes-base.salesforce"),
+			expectedIds: []string{"1"},
+		},
+		{
+			name:        "by credential",
+			match:       UsesCredential("cred-slack"),
+			expectedIds: []string{"2"},
+		},
+		{
+			name:        "no match",
+			match:       UsesCredential("cred-unknown"),
+			expectedIds: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := listWorkflowsServer(t, nil)
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			w := NewWorkflows(c)
+
+			workflows, err := w.FindWorkflows(ListWorkflowsOptions{}, tt.match)
+
+			assert.NoError(t, err)
+			var ids []string
+			for _, workflow := range workflows {
+				ids = append(ids, workflow.Id)
+			}
+			assert.Equal(t, tt.expectedIds, ids)
+		})
+	}
+}
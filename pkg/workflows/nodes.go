@@ -1,34 +1,44 @@
 package workflows
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
 )
 
 type N8nNode struct {
 	Id               string                 `json:"id,omitempty"`
 	Name             string                 `json:"name"`
-	WebhookId        string                 `json:"webhookId"`
-	Disabled         bool                   `json:"disabled"`
-	NotesInFlow      bool                   `json:"notesInFlow"`
-	Notes            string                 `json:"notes"`
+	WebhookId        string                 `json:"webhookId,omitempty"`
+	Disabled         bool                   `json:"disabled,omitempty"`
+	NotesInFlow      bool                   `json:"notesInFlow,omitempty"`
+	Notes            string                 `json:"notes,omitempty"`
 	Type             string                 `json:"type"`
 	TypeVersion      float64                `json:"typeVersion"`
-	ExecuteOnce      bool                   `json:"executeOnce"`
-	AlwaysOutputData bool                   `json:"alwaysOutputData"`
-	RetryOnFail      bool                   `json:"retryOnFail"`
-	MaxTries         int                    `json:"maxTries"`
-	WaitBetweenTries int                    `json:"waitBetweenTries"`
-	ContinueOnFail   bool                   `json:"continueOnFail"`
-	OnError          string                 `json:"onError"`
-	Parameters       map[string]interface{} `json:"parameters,omitempty"`
+	ExecuteOnce      bool                   `json:"executeOnce,omitempty"`
+	AlwaysOutputData bool                   `json:"alwaysOutputData,omitempty"`
+	RetryOnFail      bool                   `json:"retryOnFail,omitempty"`
+	MaxTries         int                    `json:"maxTries,omitempty"`
+	WaitBetweenTries int                    `json:"waitBetweenTries,omitempty"`
+	ContinueOnFail   bool                   `json:"continueOnFail,omitempty"`
+	OnError          string                 `json:"onError,omitempty"`
+	Parameters       map[string]interface{} `json:"parameters"`
 	Position         []int                  `json:"position"`
 	Credentials      map[string]interface{} `json:"credentials,omitempty"`
+	// Extra holds the fields not declared above, such as extendsCredential
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // GetNodes retrieves all nodes from a workflow
 func (w *Workflows) GetNodes(workflowId string) ([]N8nNode, error) {
-	workflow, err := w.GetWorkflow(workflowId)
+	return w.GetNodesContext(context.Background(), workflowId)
+}
+
+// GetNodesContext is like GetNodes but binds the request to ctx
+func (w *Workflows) GetNodesContext(ctx context.Context, workflowId string) ([]N8nNode, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return nil, err
@@ -39,7 +49,12 @@ func (w *Workflows) GetNodes(workflowId string) ([]N8nNode, error) {
 
 // GetNodeById retrieves a node by its ID
 func (w *Workflows) GetNodeById(workflowId string, nodeId string) (N8nNode, error) {
-	workflow, err := w.GetWorkflow(workflowId)
+	return w.GetNodeByIdContext(context.Background(), workflowId, nodeId)
+}
+
+// GetNodeByIdContext is like GetNodeById but binds the request to ctx
+func (w *Workflows) GetNodeByIdContext(ctx context.Context, workflowId string, nodeId string) (N8nNode, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return N8nNode{}, err
@@ -51,12 +66,17 @@ func (w *Workflows) GetNodeById(workflowId string, nodeId string) (N8nNode, erro
 		}
 	}
 
-	return N8nNode{}, nil
+	return N8nNode{}, fmt.Errorf("node with id %s not found in workflow %s: %w", nodeId, workflowId, client.ErrNotFound)
 }
 
 // GetNodeByName retrieves a node by its name
 func (w *Workflows) GetNodeByName(workflowId string, nodeName string) (N8nNode, error) {
-	workflow, err := w.GetWorkflow(workflowId)
+	return w.GetNodeByNameContext(context.Background(), workflowId, nodeName)
+}
+
+// GetNodeByNameContext is like GetNodeByName but binds the request to ctx
+func (w *Workflows) GetNodeByNameContext(ctx context.Context, workflowId string, nodeName string) (N8nNode, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return N8nNode{}, err
@@ -68,16 +88,21 @@ func (w *Workflows) GetNodeByName(workflowId string, nodeName string) (N8nNode,
 		}
 	}
 
-	return N8nNode{}, nil
+	return N8nNode{}, fmt.Errorf("node with name %s not found in workflow %s: %w", nodeName, workflowId, client.ErrNotFound)
 }
 
 // AddNode adds a new node to a workflow
 func (w *Workflows) AddNode(workflowId string, newNode N8nNode) (N8nNode, error) {
+	return w.AddNodeContext(context.Background(), workflowId, newNode)
+}
+
+// AddNodeContext is like AddNode but binds every request to ctx
+func (w *Workflows) AddNodeContext(ctx context.Context, workflowId string, newNode N8nNode) (N8nNode, error) {
 	if err := w.validateNodeInput(newNode); err != nil {
 		return N8nNode{}, err
 	}
 
-	workflow, err := w.GetWorkflow(workflowId)
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return N8nNode{}, err
@@ -103,25 +128,30 @@ func (w *Workflows) AddNode(workflowId string, newNode N8nNode) (N8nNode, error)
 
 	workflow.Nodes = finalNodes
 
-	_, err = w.UpdateWorkflow(workflowId, workflow)
+	updatedWorkflow, err := w.UpdateWorkflowContext(ctx, workflowId, workflow)
 
 	if err != nil {
 		return N8nNode{}, err
 	}
 
-	addedNode, err := w.GetNodeByName(workflowId, newNode.Name)
-
-	if err != nil {
-		return N8nNode{}, err
+	// the update response already holds the stored nodes, including the generated id
+	for _, node := range updatedWorkflow.Nodes {
+		if node.Name == newNode.Name {
+			return node, nil
+		}
 	}
 
-	return addedNode, nil
-
+	return N8nNode{}, fmt.Errorf("node with name %s not found in workflow %s after adding it: %w", newNode.Name, workflowId, client.ErrNotFound)
 }
 
 // RemoveNode removes a node from a workflow
 func (w *Workflows) RemoveNode(workflowId string, nodeId string) (bool, error) {
-	workflow, err := w.GetWorkflow(workflowId)
+	return w.RemoveNodeContext(context.Background(), workflowId, nodeId)
+}
+
+// RemoveNodeContext is like RemoveNode but binds every request to ctx
+func (w *Workflows) RemoveNodeContext(ctx context.Context, workflowId string, nodeId string) (bool, error) {
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return false, err
@@ -135,9 +165,13 @@ func (w *Workflows) RemoveNode(workflowId string, nodeId string) (bool, error) {
 		}
 	}
 
+	if len(finalNodes) == len(workflow.Nodes) {
+		return false, fmt.Errorf("node with id %s not found in workflow %s: %w", nodeId, workflowId, client.ErrNotFound)
+	}
+
 	workflow.Nodes = finalNodes
 
-	_, err = w.UpdateWorkflow(workflowId, workflow)
+	_, err = w.UpdateWorkflowContext(ctx, workflowId, workflow)
 
 	if err != nil {
 		return false, err
@@ -148,35 +182,46 @@ func (w *Workflows) RemoveNode(workflowId string, nodeId string) (bool, error) {
 
 // UpdateNode updates an existing node
 func (w *Workflows) UpdateNode(workflowId string, nodeId string, updateNode N8nNode) (N8nNode, error) {
+	return w.UpdateNodeContext(context.Background(), workflowId, nodeId, updateNode)
+}
+
+// UpdateNodeContext is like UpdateNode but binds every request to ctx
+func (w *Workflows) UpdateNodeContext(ctx context.Context, workflowId string, nodeId string, updateNode N8nNode) (N8nNode, error) {
 	if err := w.validateNodeInput(updateNode); err != nil {
 		return N8nNode{}, err
 	}
 
-	workflow, err := w.GetWorkflow(workflowId)
+	workflow, err := w.GetWorkflowContext(ctx, workflowId)
 
 	if err != nil {
 		return N8nNode{}, err
 	}
 
 	var finalNodes []N8nNode
+	found := false
 
 	for _, node := range workflow.Nodes {
 		if node.Id == nodeId {
 			finalNodes = append(finalNodes, w.combineNodes(node, updateNode))
+			found = true
 		} else {
 			finalNodes = append(finalNodes, node)
 		}
 	}
 
+	if !found {
+		return N8nNode{}, fmt.Errorf("node with id %s not found in workflow %s: %w", nodeId, workflowId, client.ErrNotFound)
+	}
+
 	workflow.Nodes = finalNodes
 
-	_, err = w.UpdateWorkflow(workflowId, workflow)
+	_, err = w.UpdateWorkflowContext(ctx, workflowId, workflow)
 
 	if err != nil {
 		return N8nNode{}, err
 	}
 
-	updatedNode, err := w.GetNodeById(workflowId, nodeId)
+	updatedNode, err := w.GetNodeByIdContext(ctx, workflowId, nodeId)
 
 	if err != nil {
 		return N8nNode{}, err
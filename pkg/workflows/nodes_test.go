@@ -1,6 +1,7 @@
 package workflows
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -77,11 +78,12 @@ func TestGetNodes(t *testing.T) {
 
 func TestGetNodeById(t *testing.T) {
 	tests := []struct {
-		name         string
-		server       *httptest.Server
-		nodeId       string
-		expectError  bool
-		expectedName string
+		name          string
+		server        *httptest.Server
+		nodeId        string
+		expectError   bool
+		expectErrorIs error
+		expectedName  string
 	}{
 		{
 			name: "successful get node by id",
@@ -120,6 +122,31 @@ func TestGetNodeById(t *testing.T) {
 			expectError:  false,
 			expectedName: "When chat message received",
 		},
+		{
+			name: "node not found",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":          "1",
+					"name":        "Test Workflow",
+					"nodes":       []map[string]interface{}{},
+					"connections": map[string]interface{}{},
+				})
+			})),
+			nodeId:        "missing",
+			expectError:   true,
+			expectErrorIs: client.ErrNotFound,
+		},
+		{
+			name: "workflow not found",
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"message":"Not Found"}`))
+			})),
+			nodeId:        "b24b05a7-d802-4413-bfb1-23e1e76f6203",
+			expectError:   true,
+			expectErrorIs: client.ErrNotFound,
+		},
 	}
 
 	for _, tt := range tests {
@@ -135,6 +162,9 @@ func TestGetNodeById(t *testing.T) {
 			node, err := w.GetNodeById("1", tt.nodeId)
 			if tt.expectError {
 				assert.Error(t, err)
+				if tt.expectErrorIs != nil {
+					assert.ErrorIs(t, err, tt.expectErrorIs)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedName, node.Name)
@@ -224,6 +254,40 @@ func TestAddNode(t *testing.T) {
 	}
 }
 
+func TestAddNodeContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		// the caller gives up after the initial read, the PUT must never be sent
+		cancel()
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":          "1",
+			"name":        "Test Workflow",
+			"nodes":       []map[string]interface{}{},
+			"connections": map[string]interface{}{},
+		})
+	}))
+	defer server.Close()
+
+	host := server.URL
+	token := "test"
+	c, _ := client.NewClient(&host, &token)
+	w := NewWorkflows(c)
+
+	_, err := w.AddNodeContext(ctx, "1", N8nNode{
+		Name:     "When chat message received",
+		Type:     "@n8n/n8n-nodes-langchain.chatTrigger",
+		Position: []int{100, 100},
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"GET"}, methods)
+}
+
 func TestUpdateNode(t *testing.T) {
 	tests := []struct {
 		name        string
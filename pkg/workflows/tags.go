@@ -0,0 +1,121 @@
+package workflows
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/kevop-s/n8n-client-go/pkg/tags"
+)
+
+// GetWorkflowTags retrieves the tags of a workflow
+func (w *Workflows) GetWorkflowTags(workflowId string) ([]tags.N8nTag, error) {
+	return w.GetWorkflowTagsContext(context.Background(), workflowId)
+}
+
+// GetWorkflowTagsContext is like GetWorkflowTags but binds the request to ctx
+func (w *Workflows) GetWorkflowTagsContext(ctx context.Context, workflowId string) ([]tags.N8nTag, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/workflows/%s/tags", w.Client.HostURL, url.PathEscape(workflowId)), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := w.Client.DoRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var workflowTags []tags.N8nTag
+	err = json.Unmarshal(resp, &workflowTags)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return workflowTags, nil
+}
+
+// UpdateWorkflowTags replaces the tags of a workflow with the given tag IDs
+func (w *Workflows) UpdateWorkflowTags(workflowId string, tagIds []string) ([]tags.N8nTag, error) {
+	return w.UpdateWorkflowTagsContext(context.Background(), workflowId, tagIds)
+}
+
+// UpdateWorkflowTagsContext is like UpdateWorkflowTags but binds the request to ctx
+func (w *Workflows) UpdateWorkflowTagsContext(ctx context.Context, workflowId string, tagIds []string) ([]tags.N8nTag, error) {
+	body := make([]map[string]string, 0, len(tagIds))
+	for _, tagId := range tagIds {
+		body = append(body, map[string]string{"id": tagId})
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/workflows/%s/tags", w.Client.HostURL, url.PathEscape(workflowId)), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.Client.DoRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var workflowTags []tags.N8nTag
+	err = json.Unmarshal(resp, &workflowTags)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return workflowTags, nil
+}
+
+// SetWorkflowTagsByName replaces the tags of a workflow with the tags of the
+// given names, creating the tags that do not exist yet
+func (w *Workflows) SetWorkflowTagsByName(workflowId string, names []string) ([]tags.N8nTag, error) {
+	return w.SetWorkflowTagsByNameContext(context.Background(), workflowId, names)
+}
+
+// SetWorkflowTagsByNameContext is like SetWorkflowTagsByName but binds every request to ctx
+func (w *Workflows) SetWorkflowTagsByNameContext(ctx context.Context, workflowId string, names []string) ([]tags.N8nTag, error) {
+	n8nTags := tags.NewTags(w.Client)
+
+	existingTags, err := n8nTags.ListTagsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tagIds := make(map[string]string, len(existingTags))
+	for _, tag := range existingTags {
+		tagIds[tag.Name] = tag.Id
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, ok := tagIds[name]
+		if !ok {
+			tag, err := n8nTags.CreateTagContext(ctx, name)
+			if errors.Is(err, client.ErrConflict) {
+				// created by someone else since the tags were listed
+				tag, err = n8nTags.GetTagByNameContext(ctx, name)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error creating tag %s: %w", name, err)
+			}
+			id = tag.Id
+			tagIds[name] = id
+		}
+		ids = append(ids, id)
+	}
+
+	return w.UpdateWorkflowTagsContext(ctx, workflowId, ids)
+}
@@ -0,0 +1,135 @@
+package workflows
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/kevop-s/n8n-client-go/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWorkflowTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/workflows/1/tags", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[{"id":"t1","name":"billing"}]`))
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	w := NewWorkflows(c)
+
+	result, err := w.GetWorkflowTags("1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []tags.N8nTag{{Id: "t1", Name: "billing"}}, result)
+}
+
+func TestUpdateWorkflowTags(t *testing.T) {
+	tests := []struct {
+		name         string
+		tagIds       []string
+		expectedBody string
+	}{
+		{
+			name:         "set tags",
+			tagIds:       []string{"t1", "t2"},
+			expectedBody: `[{"id":"t1"},{"id":"t2"}]`,
+		},
+		{
+			name:         "clear tags",
+			tagIds:       nil,
+			expectedBody: `[]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "PUT", r.Method)
+				assert.Equal(t, "/workflows/1/tags", r.URL.Path)
+				body, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, tt.expectedBody, string(body))
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`[]`))
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			w := NewWorkflows(c)
+
+			_, err := w.UpdateWorkflowTags("1", tt.tagIds)
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSetWorkflowTagsByName(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /tags":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data":       []map[string]string{{"id": "t1", "name": "billing"}},
+				"nextCursor": nil,
+			})
+		case "POST /tags":
+			var tag map[string]string
+			json.Unmarshal(body, &tag)
+			json.NewEncoder(w).Encode(map[string]string{"id": fmt.Sprintf("t%d", len(requests)), "name": tag["name"]})
+		case "PUT /workflows/1/tags":
+			assert.JSONEq(t, `[{"id":"t1"},{"id":"t2"},{"id":"t3"}]`, string(body))
+			w.Write([]byte(`[{"id":"t1","name":"billing"},{"id":"t2","name":"team:payments"},{"id":"t3","name":"team:ops"}]`))
+		}
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	w := NewWorkflows(c)
+
+	result, err := w.SetWorkflowTagsByName("1", []string{"billing", "team:payments", "team:ops"})
+
+	// the tags are listed once, only the missing ones are created
+	assert.NoError(t, err)
+	assert.Len(t, result, 3)
+	assert.Equal(t, []string{"GET /tags", "POST /tags", "POST /tags", "PUT /workflows/1/tags"}, requests)
+}
+
+func TestWorkflowBodiesOmitTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.Method == "GET" {
+			w.Write([]byte(`{"id":"1","name":"Tagged","nodes":[],"connections":{},"settings":{},"tags":[{"id":"t1","name":"billing"}]}`))
+			return
+		}
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.NotContains(t, body, "tags")
+		w.Write([]byte(`{"id":"1","name":"Tagged"}`))
+	}))
+	defer server.Close()
+
+	c, _ := client.New(server.URL, "test")
+	w := NewWorkflows(c)
+
+	workflow, err := w.GetWorkflow("1")
+	assert.NoError(t, err)
+	assert.Equal(t, []tags.N8nTag{{Id: "t1", Name: "billing"}}, workflow.Tags)
+
+	_, err = w.UpdateWorkflow("1", N8nWorkflow{Name: "Tagged"})
+	assert.NoError(t, err)
+
+	_, err = w.CreateWorkflow(N8nWorkflow{Name: "Tagged", Tags: workflow.Tags})
+	assert.NoError(t, err)
+}
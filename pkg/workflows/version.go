@@ -0,0 +1,172 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+)
+
+// ErrVersionConflict is matched by VersionConflictError through errors.Is
+var ErrVersionConflict = errors.New("workflow version conflict")
+
+// VersionConflictError is returned when a workflow changed on the server between
+// the moment it was read and the moment it was about to be written. It matches
+// ErrVersionConflict and client.ErrConflict
+type VersionConflictError struct {
+	WorkflowId        string
+	ExpectedVersionId string
+	ActualVersionId   string
+	// ConflictingNodes lists the nodes changed by both writers when a rebase was refused
+	ConflictingNodes []string
+}
+
+func (e *VersionConflictError) Error() string {
+	message := fmt.Sprintf("workflow %s was changed on the server: expected version %s, found %s", e.WorkflowId, e.ExpectedVersionId, e.ActualVersionId)
+	if len(e.ConflictingNodes) > 0 {
+		message += fmt.Sprintf(", nodes changed by both writers: %v", e.ConflictingNodes)
+	}
+	return message
+}
+
+// Is matches ErrVersionConflict and client.ErrConflict
+func (e *VersionConflictError) Is(target error) bool {
+	return target == ErrVersionConflict || target == client.ErrConflict
+}
+
+// EditOptions configures EditWithOptions. The version check is best effort:
+// the workflow is read again and then written without any precondition on the
+// server, so a change made between those two requests is still overwritten
+type EditOptions struct {
+	// CheckVersion reads the version of the workflow again right before saving
+	// it and fails with a VersionConflictError when another writer changed it.
+	// It costs an extra request and is not atomic
+	CheckVersion bool
+	// Rebase is the number of times the edit is applied again on the latest
+	// version after a conflict, as long as the other writer changed other nodes
+	// than the edit. It implies CheckVersion
+	Rebase int
+}
+
+// checkVersion returns a VersionConflictError when expected and current are
+// different versions. Workflows without versionId are never in conflict. As n8n
+// offers no conditional update, a change made after current was read and
+// before the update is sent goes undetected
+func checkVersion(id string, expected string, current string) error {
+	if expected == "" || current == "" || expected == current {
+		return nil
+	}
+	return &VersionConflictError{WorkflowId: id, ExpectedVersionId: expected, ActualVersionId: current}
+}
+
+// EditWithOptions is like Edit but lets the version check be disabled, or
+// rebases the edit on concurrent changes. On rebase edit runs again on a draft of the latest version, so
+// it should only describe changes and not depend on state from a previous run
+func (w *Workflows) EditWithOptions(id string, opts EditOptions, edit func(*WorkflowDraft) error) (N8nWorkflow, error) {
+	return w.EditWithOptionsContext(context.Background(), id, opts, edit)
+}
+
+// EditWithOptionsContext is like EditWithOptions but binds every request to ctx
+func (w *Workflows) EditWithOptionsContext(ctx context.Context, id string, opts EditOptions, edit func(*WorkflowDraft) error) (N8nWorkflow, error) {
+	base, err := w.GetWorkflowContext(ctx, id)
+	if err != nil {
+		return N8nWorkflow{}, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		draft, err := w.newDraft(base)
+		if err != nil {
+			return N8nWorkflow{}, err
+		}
+
+		if err := edit(draft); err != nil {
+			return N8nWorkflow{}, err
+		}
+
+		if err := draft.validate(); err != nil {
+			return N8nWorkflow{}, fmt.Errorf("invalid draft of workflow %s: %w", id, err)
+		}
+
+		if !opts.CheckVersion && opts.Rebase <= 0 {
+			return w.putWorkflow(ctx, id, draft.Workflow())
+		}
+
+		latest, err := w.GetWorkflowContext(ctx, id)
+		if err != nil {
+			return N8nWorkflow{}, err
+		}
+
+		err = checkVersion(id, base.VersionId, latest.VersionId)
+		if err == nil {
+			return w.putWorkflow(ctx, id, draft.Workflow())
+		}
+
+		if attempt >= opts.Rebase {
+			return N8nWorkflow{}, err
+		}
+
+		overlap := intersect(changedNodes(base, draft.Workflow()), changedNodes(base, latest))
+		if len(overlap) > 0 {
+			conflict := err.(*VersionConflictError)
+			conflict.ConflictingNodes = overlap
+			return N8nWorkflow{}, conflict
+		}
+
+		base = latest
+	}
+}
+
+// changedNodes returns the names of the nodes added, removed or changed between
+// two versions of a workflow, including nodes whose outgoing connections changed
+func changedNodes(from N8nWorkflow, to N8nWorkflow) []string {
+	fromNodes := nodeSnapshots(from)
+	toNodes := nodeSnapshots(to)
+
+	var changed []string
+	for name, snapshot := range fromNodes {
+		if toNodes[name] != snapshot {
+			changed = append(changed, name)
+		}
+	}
+	for name := range toNodes {
+		if _, ok := fromNodes[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+
+	sort.Strings(changed)
+	return changed
+}
+
+// nodeSnapshots encodes every node of a workflow with its outgoing connections
+func nodeSnapshots(workflow N8nWorkflow) map[string]string {
+	graph, _ := workflow.ConnectionGraph()
+
+	snapshots := make(map[string]string, len(workflow.Nodes))
+	for _, node := range workflow.Nodes {
+		snapshot, _ := json.Marshal(struct {
+			Node        N8nNode
+			Connections map[string][][]N8nConnectionTarget
+		}{node, graph[node.Name]})
+		snapshots[node.Name] = string(snapshot)
+	}
+	return snapshots
+}
+
+func intersect(a []string, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, name := range b {
+		inB[name] = true
+	}
+
+	var both []string
+	for _, name := range a {
+		if inB[name] {
+			both = append(both, name)
+		}
+	}
+	return both
+}
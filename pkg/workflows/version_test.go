@@ -0,0 +1,226 @@
+package workflows
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// versionedWorkflow returns edgeWorkflow at the given version, with Audit notes
+// set to auditNotes when not empty
+func versionedWorkflow(t *testing.T, versionId string, auditNotes string) string {
+	var workflow map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(edgeWorkflow), &workflow))
+
+	workflow["versionId"] = versionId
+	if auditNotes != "" {
+		nodes := workflow["nodes"].([]interface{})
+		nodes[2].(map[string]interface{})["notes"] = auditNotes
+	}
+
+	encoded, _ := json.Marshal(workflow)
+	return string(encoded)
+}
+
+// newVersionServer answers the GET requests with versions in order, repeating
+// the last one, and records every request and the body of the PUT
+func newVersionServer(versions []string, requests *[]string, putBody *N8nWorkflow) *httptest.Server {
+	gets := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method)
+		w.WriteHeader(http.StatusOK)
+		if r.Method == "PUT" {
+			json.NewDecoder(r.Body).Decode(putBody)
+			w.Write([]byte(versions[len(versions)-1]))
+			return
+		}
+		w.Write([]byte(versions[gets]))
+		if gets < len(versions)-1 {
+			gets++
+		}
+	}))
+}
+
+func TestUpdateWorkflowVersionConflict(t *testing.T) {
+	tests := []struct {
+		name             string
+		versionId        string
+		expectConflict   bool
+		expectedRequests []string
+	}{
+		{
+			name:             "stale version",
+			versionId:        "v1",
+			expectConflict:   true,
+			expectedRequests: []string{"GET"},
+		},
+		{
+			name:             "current version",
+			versionId:        "v2",
+			expectedRequests: []string{"GET", "PUT"},
+		},
+		{
+			name:             "no version tracked",
+			expectedRequests: []string{"GET", "PUT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			var putBody N8nWorkflow
+			server := newVersionServer([]string{versionedWorkflow(t, "v2", "")}, &requests, &putBody)
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			w := NewWorkflows(c)
+
+			_, err := w.UpdateWorkflow("1", N8nWorkflow{Name: "Edges", VersionId: tt.versionId})
+
+			assert.Equal(t, tt.expectedRequests, requests)
+			if !tt.expectConflict {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.True(t, errors.Is(err, ErrVersionConflict))
+			assert.True(t, errors.Is(err, client.ErrConflict))
+
+			var conflict *VersionConflictError
+			assert.True(t, errors.As(err, &conflict))
+			assert.Equal(t, "v1", conflict.ExpectedVersionId)
+			assert.Equal(t, "v2", conflict.ActualVersionId)
+		})
+	}
+}
+
+func TestEditWithOptions(t *testing.T) {
+	changeLogNotes := func(d *WorkflowDraft) error {
+		return d.UpdateNode("Log", N8nNode{Name: "Log", Type: "n8n-nodes-base.noOp", Position: []int{200, 0}, Notes: "ours"})
+	}
+	changeAuditNotes := func(d *WorkflowDraft) error {
+		return d.UpdateNode("Audit", N8nNode{Name: "Audit", Type: "n8n-nodes-base.noOp", Position: []int{200, 200}, Notes: "ours"})
+	}
+
+	tests := []struct {
+		name                     string
+		opts                     EditOptions
+		edit                     func(d *WorkflowDraft) error
+		versions                 func(t *testing.T) []string
+		expectedRequests         []string
+		expectedConflictingNodes []string
+		expectConflict           bool
+		expectedAuditNotes       string
+	}{
+		{
+			name: "unchanged version is saved",
+			opts: EditOptions{CheckVersion: true},
+			edit: changeLogNotes,
+			versions: func(t *testing.T) []string {
+				return []string{versionedWorkflow(t, "v1", "")}
+			},
+			expectedRequests: []string{"GET", "GET", "PUT"},
+		},
+		{
+			name: "changed version without rebase",
+			opts: EditOptions{CheckVersion: true},
+			edit: changeLogNotes,
+			versions: func(t *testing.T) []string {
+				return []string{versionedWorkflow(t, "v1", ""), versionedWorkflow(t, "v2", "theirs")}
+			},
+			expectedRequests: []string{"GET", "GET"},
+			expectConflict:   true,
+		},
+		{
+			name: "rebase over changes to other nodes",
+			opts: EditOptions{Rebase: 1},
+			edit: changeLogNotes,
+			versions: func(t *testing.T) []string {
+				return []string{versionedWorkflow(t, "v1", ""), versionedWorkflow(t, "v2", "theirs")}
+			},
+			expectedRequests:   []string{"GET", "GET", "GET", "PUT"},
+			expectedAuditNotes: "theirs",
+		},
+		{
+			name: "rebase refused over changes to the same node",
+			opts: EditOptions{Rebase: 1},
+			edit: changeAuditNotes,
+			versions: func(t *testing.T) []string {
+				return []string{versionedWorkflow(t, "v1", ""), versionedWorkflow(t, "v2", "theirs")}
+			},
+			expectedRequests:         []string{"GET", "GET"},
+			expectConflict:           true,
+			expectedConflictingNodes: []string{"Audit"},
+		},
+		{
+			name: "rebase refused over in place changes to the same node",
+			opts: EditOptions{Rebase: 1},
+			edit: func(d *WorkflowDraft) error {
+				node, _ := d.Node("Audit")
+				node.Parameters["mode"] = "ours"
+				return nil
+			},
+			versions: func(t *testing.T) []string {
+				return []string{versionedWorkflow(t, "v1", ""), versionedWorkflow(t, "v2", "theirs")}
+			},
+			expectedRequests:         []string{"GET", "GET"},
+			expectConflict:           true,
+			expectedConflictingNodes: []string{"Audit"},
+		},
+		{
+			name: "rebase attempts exhausted",
+			opts: EditOptions{Rebase: 1},
+			edit: changeLogNotes,
+			versions: func(t *testing.T) []string {
+				return []string{versionedWorkflow(t, "v1", ""), versionedWorkflow(t, "v2", "theirs"), versionedWorkflow(t, "v3", "theirs again")}
+			},
+			expectedRequests: []string{"GET", "GET", "GET"},
+			expectConflict:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			var putBody N8nWorkflow
+			server := newVersionServer(tt.versions(t), &requests, &putBody)
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			w := NewWorkflows(c)
+
+			_, err := w.EditWithOptions("1", tt.opts, tt.edit)
+
+			assert.Equal(t, tt.expectedRequests, requests)
+			if tt.expectConflict {
+				var conflict *VersionConflictError
+				assert.True(t, errors.As(err, &conflict))
+				assert.Equal(t, tt.expectedConflictingNodes, conflict.ConflictingNodes)
+				return
+			}
+			assert.NoError(t, err)
+
+			for _, node := range putBody.Nodes {
+				switch node.Name {
+				case "Log":
+					assert.Equal(t, "ours", node.Notes)
+				case "Audit":
+					assert.Equal(t, tt.expectedAuditNotes, node.Notes)
+				}
+			}
+		})
+	}
+}
+
+func TestVersionConflictErrorMessage(t *testing.T) {
+	err := &VersionConflictError{WorkflowId: "1", ExpectedVersionId: "v1", ActualVersionId: "v2", ConflictingNodes: []string{"Log"}}
+
+	assert.True(t, strings.Contains(err.Error(), "expected version v1, found v2"))
+	assert.True(t, strings.Contains(err.Error(), "[Log]"))
+}
@@ -2,11 +2,13 @@ package workflows
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 
 	"github.com/kevop-s/n8n-client-go/pkg/client"
+	"github.com/kevop-s/n8n-client-go/pkg/tags"
 )
 
 type Workflows struct {
@@ -21,7 +23,19 @@ type N8nWorkflow struct {
 	ConnectionsMap map[string]interface{} `json:"connections"`
 	Connections    []N8nConnection        `json:"connectionsObject,omitempty"`
 	Settings       N8nWorkflowSettings    `json:"settings"`
-	StaticData     string                 `json:"staticData,omitempty"`
+	// StaticData holds the state n8n stores for the workflow, such as the last
+	// poll of a trigger node, keyed by node
+	StaticData json.RawMessage `json:"staticData,omitempty"`
+	// Tags is read only, use UpdateWorkflowTags to change the tags of a workflow
+	Tags []tags.N8nTag `json:"tags,omitempty"`
+	// VersionId identifies the version of the workflow read from n8n. UpdateWorkflow
+	// refuses to overwrite a workflow changed since this version was read
+	VersionId string `json:"versionId,omitempty"`
+	// Extra holds the fields returned by n8n that are not declared above, such as
+	// pinData or meta, so they are sent back unchanged on update. Declared fields
+	// returned with an explicit false or 0, which omitempty would drop, are kept
+	// here as well
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type N8nWorkflowSettings struct {
@@ -33,6 +47,8 @@ type N8nWorkflowSettings struct {
 	ErrorWorkflow            string `json:"errorWorkflow,omitempty"`
 	Timezone                 string `json:"timezone,omitempty"`
 	ExecutionOrder           string `json:"executionOrder,omitempty"`
+	// Extra holds the settings not declared above
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 func NewWorkflows(client *client.Client) *Workflows {
@@ -41,7 +57,12 @@ func NewWorkflows(client *client.Client) *Workflows {
 
 // GetWorkflow retrieves a workflow by its ID
 func (w *Workflows) GetWorkflow(id string) (N8nWorkflow, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/workflows/%s", w.Client.HostURL, id), nil)
+	return w.GetWorkflowContext(context.Background(), id)
+}
+
+// GetWorkflowContext is like GetWorkflow but binds the request to ctx
+func (w *Workflows) GetWorkflowContext(ctx context.Context, id string) (N8nWorkflow, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/workflows/%s", w.Client.HostURL, id), nil)
 	if err != nil {
 		return N8nWorkflow{}, err
 	}
@@ -51,8 +72,13 @@ func (w *Workflows) GetWorkflow(id string) (N8nWorkflow, error) {
 		return N8nWorkflow{}, err
 	}
 
+	return w.decodeWorkflow(resp)
+}
+
+// decodeWorkflow decodes a workflow returned by the API, including its connections object
+func (w *Workflows) decodeWorkflow(resp []byte) (N8nWorkflow, error) {
 	var workflow N8nWorkflow
-	err = json.Unmarshal(resp, &workflow)
+	err := json.Unmarshal(resp, &workflow)
 	if err != nil {
 		return N8nWorkflow{}, err
 	}
@@ -73,6 +99,11 @@ func (w *Workflows) GetWorkflow(id string) (N8nWorkflow, error) {
 
 // CreateWorkflow creates a new workflow
 func (w *Workflows) CreateWorkflow(workflowData N8nWorkflow) (N8nWorkflow, error) {
+	return w.CreateWorkflowContext(context.Background(), workflowData)
+}
+
+// CreateWorkflowContext is like CreateWorkflow but binds the request to ctx
+func (w *Workflows) CreateWorkflowContext(ctx context.Context, workflowData N8nWorkflow) (N8nWorkflow, error) {
 	if len(workflowData.Nodes) > 0 {
 		return N8nWorkflow{}, fmt.Errorf("nodes can not be defined when workflow is created, use AddNode instead")
 	}
@@ -83,13 +114,15 @@ func (w *Workflows) CreateWorkflow(workflowData N8nWorkflow) (N8nWorkflow, error
 
 	workflowData.Nodes = []N8nNode{}
 	workflowData.ConnectionsMap = map[string]interface{}{}
+	// tags are read only, n8n rejects workflows sending them
+	workflowData.Tags = nil
 	w.setDefaultWorkflowSettings(&workflowData)
 
 	jsonWorkflow, err := json.Marshal(workflowData)
 	if err != nil {
 		return N8nWorkflow{}, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/workflows", w.Client.HostURL), bytes.NewReader(jsonWorkflow))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/workflows", w.Client.HostURL), bytes.NewReader(jsonWorkflow))
 	if err != nil {
 		return N8nWorkflow{}, err
 	}
@@ -110,16 +143,82 @@ func (w *Workflows) CreateWorkflow(workflowData N8nWorkflow) (N8nWorkflow, error
 	return workflow, nil
 }
 
-// UpdateWorkflow updates an existing workflow
+// CreateWorkflowInProject creates a new workflow and moves it to the given project.
+// The public API can not create a workflow in a project directly, so when the
+// move fails the created workflow is deleted again. If that fails as well, the
+// workflow is returned along with the error so that the caller can remove it
+func (w *Workflows) CreateWorkflowInProject(workflowData N8nWorkflow, projectId string) (N8nWorkflow, error) {
+	return w.CreateWorkflowInProjectContext(context.Background(), workflowData, projectId)
+}
+
+// CreateWorkflowInProjectContext is like CreateWorkflowInProject but binds every request to ctx
+func (w *Workflows) CreateWorkflowInProjectContext(ctx context.Context, workflowData N8nWorkflow, projectId string) (N8nWorkflow, error) {
+	if projectId == "" {
+		return N8nWorkflow{}, fmt.Errorf("projectId should not be empty when creating a workflow in a project")
+	}
+
+	workflow, err := w.CreateWorkflowContext(ctx, workflowData)
+	if err != nil {
+		return N8nWorkflow{}, err
+	}
+
+	_, err = w.TransferWorkflowContext(ctx, workflow.Id, projectId)
+	if err != nil {
+		if _, deleteErr := w.DeleteWorkflowContext(ctx, workflow.Id); deleteErr != nil {
+			return workflow, fmt.Errorf("workflow %s was created but could not be moved to project %s nor deleted: %w: %w", workflow.Id, projectId, err, deleteErr)
+		}
+		return N8nWorkflow{}, fmt.Errorf("error moving the new workflow to project %s, it was deleted: %w", projectId, err)
+	}
+
+	return workflow, nil
+}
+
+// TransferWorkflow moves a workflow to another project
+func (w *Workflows) TransferWorkflow(id string, destinationProjectId string) (bool, error) {
+	return w.TransferWorkflowContext(context.Background(), id, destinationProjectId)
+}
+
+// TransferWorkflowContext is like TransferWorkflow but binds the request to ctx
+func (w *Workflows) TransferWorkflowContext(ctx context.Context, id string, destinationProjectId string) (bool, error) {
+	payload, err := json.Marshal(map[string]string{"destinationProjectId": destinationProjectId})
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/workflows/%s/transfer", w.Client.HostURL, id), bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	_, err = w.Client.DoRequest(req)
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UpdateWorkflow updates an existing workflow. When workflowData holds the
+// VersionId it was read with, a VersionConflictError is returned if the workflow
+// changed on the server since
 func (w *Workflows) UpdateWorkflow(id string, workflowData N8nWorkflow) (N8nWorkflow, error) {
-	currentWorkflow, err := w.GetWorkflow(id)
+	return w.UpdateWorkflowContext(context.Background(), id, workflowData)
+}
+
+// UpdateWorkflowContext is like UpdateWorkflow but binds every request to ctx
+func (w *Workflows) UpdateWorkflowContext(ctx context.Context, id string, workflowData N8nWorkflow) (N8nWorkflow, error) {
+	currentWorkflow, err := w.GetWorkflowContext(ctx, id)
 	if err != nil {
 		return N8nWorkflow{}, err
 	}
 
+	if err := checkVersion(id, workflowData.VersionId, currentWorkflow.VersionId); err != nil {
+		return N8nWorkflow{}, err
+	}
+
 	combinedWorkflowData := w.combineWorkflows(currentWorkflow, workflowData)
-	// remove readonly fields
-	combinedWorkflowData.Id = ""
 
 	// keep current nodes and connections if not specified in update
 	combinedWorkflowData.Nodes = currentWorkflow.Nodes
@@ -140,17 +239,36 @@ func (w *Workflows) UpdateWorkflow(id string, workflowData N8nWorkflow) (N8nWork
 		return N8nWorkflow{}, err
 	}
 
-	// remove connections from combinedWorkflowData before sending to n8n as it is an abstract type
+	return w.putWorkflow(ctx, id, combinedWorkflowData)
+}
+
+// putWorkflow replaces a workflow with workflowData, sending its connections map
+// as is. Read only fields are not sent
+func (w *Workflows) putWorkflow(ctx context.Context, id string, workflowData N8nWorkflow) (N8nWorkflow, error) {
+	// remove readonly fields, activation has its own endpoints
+	workflowData.Id = ""
+	workflowData.Active = false
+	workflowData.Tags = nil
+	extra := make(map[string]json.RawMessage, len(workflowData.Extra))
+	for key, value := range workflowData.Extra {
+		extra[key] = value
+	}
+	for _, field := range readOnlyWorkflowFields {
+		delete(extra, field)
+	}
+	workflowData.Extra = extra
+
+	// remove connections before sending to n8n as it is an abstract type
 	// we will send the connectionsMap instead
-	combinedWorkflowData.Connections = nil
+	workflowData.Connections = nil
 
-	jsonWorkflow, err := json.Marshal(combinedWorkflowData)
+	jsonWorkflow, err := json.Marshal(workflowData)
 
 	if err != nil {
 		return N8nWorkflow{}, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/workflows/%s", w.Client.HostURL, id), bytes.NewReader(jsonWorkflow))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/workflows/%s", w.Client.HostURL, id), bytes.NewReader(jsonWorkflow))
 	if err != nil {
 		return N8nWorkflow{}, err
 	}
@@ -172,7 +290,12 @@ func (w *Workflows) UpdateWorkflow(id string, workflowData N8nWorkflow) (N8nWork
 
 // DeleteWorkflow deletes a workflow by its ID
 func (w *Workflows) DeleteWorkflow(id string) (bool, error) {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/workflows/%s", w.Client.HostURL, id), nil)
+	return w.DeleteWorkflowContext(context.Background(), id)
+}
+
+// DeleteWorkflowContext is like DeleteWorkflow but binds the request to ctx
+func (w *Workflows) DeleteWorkflowContext(ctx context.Context, id string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/workflows/%s", w.Client.HostURL, id), nil)
 	if err != nil {
 		return false, err
 	}
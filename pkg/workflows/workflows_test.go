@@ -2,6 +2,7 @@ package workflows
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -202,3 +203,76 @@ func TestDeleteWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateWorkflowInProject(t *testing.T) {
+	tests := []struct {
+		name             string
+		transferStatus   int
+		deleteStatus     int
+		expectError      error
+		expectedId       string
+		expectedRequests []string
+	}{
+		{
+			name:             "moved to the project",
+			transferStatus:   http.StatusOK,
+			expectedId:       "new-workflow",
+			expectedRequests: []string{"POST /workflows", "PUT /workflows/new-workflow/transfer"},
+		},
+		{
+			name:             "deleted when the move fails",
+			transferStatus:   http.StatusForbidden,
+			deleteStatus:     http.StatusOK,
+			expectError:      client.ErrForbidden,
+			expectedRequests: []string{"POST /workflows", "PUT /workflows/new-workflow/transfer", "DELETE /workflows/new-workflow"},
+		},
+		{
+			name:             "returned when the move and the deletion fail",
+			transferStatus:   http.StatusForbidden,
+			deleteStatus:     http.StatusInternalServerError,
+			expectError:      client.ErrServer,
+			expectedId:       "new-workflow",
+			expectedRequests: []string{"POST /workflows", "PUT /workflows/new-workflow/transfer", "DELETE /workflows/new-workflow"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				switch r.Method {
+				case "PUT":
+					body, _ := io.ReadAll(r.Body)
+					assert.JSONEq(t, `{"destinationProjectId":"p1"}`, string(body))
+					w.WriteHeader(tt.transferStatus)
+					w.Write([]byte(`{}`))
+				case "DELETE":
+					w.WriteHeader(tt.deleteStatus)
+					w.Write([]byte(`{}`))
+				default:
+					w.WriteHeader(http.StatusOK)
+					json.NewEncoder(w).Encode(map[string]interface{}{
+						"id":          "new-workflow",
+						"name":        "New Workflow",
+						"connections": map[string]interface{}{},
+					})
+				}
+			}))
+			defer server.Close()
+
+			c, _ := client.New(server.URL, "test")
+			w := NewWorkflows(c)
+
+			workflow, err := w.CreateWorkflowInProject(N8nWorkflow{Name: "New Workflow"}, "p1")
+
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedId, workflow.Id)
+			assert.Equal(t, tt.expectedRequests, requests)
+		})
+	}
+}